@@ -53,6 +53,10 @@ const (
 	paramDryRun             = "dry-run"
 	paramOutput             = "output"
 	paramIntegrationMode    = "integration"
+	paramRace               = "race"
+	paramHOMOrder           = "hom-order"
+	paramHOMStrategy        = "hom-strategy"
+	paramExtreme            = "extreme"
 	paramTestCPU            = "test-cpu"
 	paramWorkers            = "workers"
 	paramTimeoutCoefficient = "timeout-coefficient"
@@ -91,6 +95,10 @@ func longExplainer() string {
 		In 'dry-run' mode, unleash only performs the analysis of the source code, but it
 		doesn't actually perform the test.
 
+		In 'extreme' mode, unleash makes each function return straight away, instead of
+		looking for the usual mutants. The functions whose tests still pass are reported
+		as pseudo-tested: it is a coarse but fast assessment of a large module.
+
 		Thresholds are configurable quality gates that make gremlins exit with an error 
 		if those values are not met. Efficacy is the percent of KILLED mutants over
 		the total KILLED and LIVED mutants. Mutant coverage is the percent of total
@@ -161,6 +169,11 @@ func run(ctx context.Context, mod gomodule.GoModule, workDir string) (report.Res
 		return report.Results{}, err
 	}
 
+	hom, err := engine.NewHigherOrder()
+	if err != nil {
+		return report.Results{}, err
+	}
+
 	c := coverage.New(workDir, mod)
 
 	cProfile, err := c.Run()
@@ -171,14 +184,14 @@ func run(ctx context.Context, mod gomodule.GoModule, workDir string) (report.Res
 	wdDealer := workdir.NewCachedDealer(workDir, mod.Root)
 	defer wdDealer.Clean()
 
-	jDealer := engine.NewExecutorDealer(mod, wdDealer, cProfile.Elapsed)
+	jDealer := engine.NewExecutorDealer(mod, wdDealer, cProfile.Elapsed, engine.WithBaselineRaces(cProfile.Races))
 
 	codeData := engine.CodeData{
 		Cov:  cProfile.Profile,
 		Diff: fDiff,
 	}
 
-	mut := engine.New(mod, codeData, jDealer)
+	mut := engine.New(mod, codeData, jDealer, engine.WithHigherOrder(hom))
 	results := mut.Run(ctx)
 
 	return results, nil
@@ -203,6 +216,10 @@ func setFlagsOnCmd(cmd *cobra.Command) error {
 		{Name: paramDiff, CfgKey: configuration.UnleashDiffRef, Shorthand: "D", DefaultV: "", Usage: "diff branch or commit"},
 		{Name: paramOutput, CfgKey: configuration.UnleashOutputKey, Shorthand: "o", DefaultV: "", Usage: "set the output file for machine readable results"},
 		{Name: paramIntegrationMode, CfgKey: configuration.UnleashIntegrationMode, Shorthand: "i", DefaultV: false, Usage: "makes Gremlins run the complete test suite for each mutation"},
+		{Name: paramRace, CfgKey: configuration.UnleashRaceKey, DefaultV: false, Usage: "run the tests with the race detector enabled"},
+		{Name: paramHOMOrder, CfgKey: configuration.UnleashHOMOrderKey, DefaultV: 0, Usage: "the number of mutants combined in each higher-order mutant, disabled if lower than 2"},
+		{Name: paramHOMStrategy, CfgKey: configuration.UnleashHOMStrategyKey, DefaultV: engine.HOMRandom, Usage: "the strategy to combine higher-order mutants: random, same-function or adjacent"},
+		{Name: paramExtreme, CfgKey: configuration.UnleashExtremeKey, DefaultV: false, Usage: "remove the body of each function and report the pseudo-tested ones"},
 		{Name: paramThresholdEfficacy, CfgKey: configuration.UnleashThresholdEfficacyKey, DefaultV: float64(0), Usage: "threshold for code-efficacy percent"},
 		{Name: paramThresholdMCoverage, CfgKey: configuration.UnleashThresholdMCoverageKey, DefaultV: float64(0), Usage: "threshold for mutant-coverage percent"},
 		{Name: paramWorkers, CfgKey: configuration.UnleashWorkersKey, DefaultV: 0, Usage: "the number of workers to use in mutation testing"},
@@ -220,8 +237,12 @@ func setFlagsOnCmd(cmd *cobra.Command) error {
 	return setMutantTypeFlags(cmd)
 }
 
+// setMutantTypeFlags sets the flags enabling the built-in mutator.Type and
+// the ones registered before the command is created.
 func setMutantTypeFlags(cmd *cobra.Command) error {
-	for _, mt := range mutator.Types {
+	types := append([]mutator.Type{}, mutator.Types...)
+	types = append(types, mutator.Registered()...)
+	for _, mt := range types {
 		name := mt.String()
 		usage := fmt.Sprintf("enable %q mutants", name)
 		param := strings.ReplaceAll(name, "_", "-")
@@ -125,6 +125,26 @@ func TestUnleash(t *testing.T) {
 			flagType:  "string",
 			defValue:  "",
 		},
+		{
+			name:     "race",
+			flagType: "bool",
+			defValue: "false",
+		},
+		{
+			name:     "hom-order",
+			flagType: "int",
+			defValue: "0",
+		},
+		{
+			name:     "hom-strategy",
+			flagType: "string",
+			defValue: "random",
+		},
+		{
+			name:     "extreme",
+			flagType: "bool",
+			defValue: "false",
+		},
 		{
 			name:     "remove-self-assignments",
 			flagType: "bool",
@@ -41,9 +41,17 @@ const (
 	UnleashTestCPUKey            = "unleash.test-cpu"
 	UnleashTimeoutCoefficientKey = "unleash.timeout-coefficient"
 	UnleashIntegrationMode       = "unleash.integration"
+	UnleashRaceKey               = "unleash.race"
+	UnleashHOMOrderKey           = "unleash.hom.order"
+	UnleashHOMStrategyKey        = "unleash.hom.strategy"
+	UnleashExtremeKey            = "unleash.extreme"
 	UnleashDiffRef               = "unleash.diff"
 	UnleashThresholdEfficacyKey  = "unleash.threshold.efficacy"
 	UnleashThresholdMCoverageKey = "unleash.threshold.mutant-coverage"
+
+	MutantAPISwapPairsKey     = "mutants.api-swap.pairs"
+	MutantAPISwapNegationsKey = "mutants.api-swap.negations"
+	MutantRulesKey            = "mutants.rules"
 )
 
 const (
@@ -185,6 +193,104 @@ func Get[T any](k string) T {
 	return r
 }
 
+// GetStrings offers synchronised access to a list of strings in Viper.
+// Differently from Get, it accepts the generic lists read from the
+// configuration file, skipping the elements that are not strings.
+func GetStrings(k string) []string {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	return toStrings(viper.Get(k))
+}
+
+// GetStringLists offers synchronised access to a list of lists of strings
+// in Viper, such as:
+//
+//	pairs:
+//	  - [a, b]
+//	  - [c, d]
+func GetStringLists(k string) [][]string {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	var r [][]string
+	switch v := viper.Get(k).(type) {
+	case [][]string:
+		r = v
+	case []any:
+		for _, e := range v {
+			r = append(r, toStrings(e))
+		}
+	}
+
+	return r
+}
+
+func toStrings(v any) []string {
+	switch v := v.(type) {
+	case []string:
+		return v
+	case []any:
+		r := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				r = append(r, s)
+			}
+		}
+
+		return r
+	}
+
+	return nil
+}
+
+// Rule is a user-defined mutation, in the form of the rewrite rules of
+// gofmt -r. In the configuration file it is declared as:
+//
+//	mutants:
+//	  rules:
+//	    - name: retry-zero
+//	      pattern: a.Retry(x)
+//	      replacement: a.Retry(0)
+//	      enabled: true
+//
+// The rules without the enabled key are enabled.
+type Rule struct {
+	Name        string
+	Pattern     string
+	Replacement string
+	Enabled     bool
+}
+
+// GetRules offers synchronised access to the Rule in Viper.
+func GetRules() []Rule {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	var r []Rule
+	switch v := viper.Get(MutantRulesKey).(type) {
+	case []Rule:
+		r = v
+	case []any:
+		for _, e := range v {
+			m, ok := e.(map[string]any)
+			if !ok {
+				continue
+			}
+			rule := Rule{Enabled: true}
+			rule.Name, _ = m["name"].(string)
+			rule.Pattern, _ = m["pattern"].(string)
+			rule.Replacement, _ = m["replacement"].(string)
+			if enabled, ok := m["enabled"].(bool); ok {
+				rule.Enabled = enabled
+			}
+			r = append(r, rule)
+		}
+	}
+
+	return r
+}
+
 // Reset is used mainly for testing purposes, in order to clean up the Viper
 // instance.
 func Reset() {
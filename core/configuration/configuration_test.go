@@ -290,6 +290,41 @@ func TestViperSynchronisedAccess(t *testing.T) {
 	}
 }
 
+func TestStringLists(t *testing.T) {
+	if err := Init([]string{"testdata/config3/.gremlins.yaml"}); err != nil {
+		t.Fatal(err)
+	}
+	defer viper.Reset()
+
+	wantLists := [][]string{
+		{"example.com/lib.Retry", "example.com/lib.RetryOnce"},
+		{"example.com/lib.Open", "example.com/lib.Create"},
+	}
+	if got := GetStringLists(MutantAPISwapPairsKey); !cmp.Equal(got, wantLists) {
+		t.Errorf(cmp.Diff(wantLists, got))
+	}
+
+	wantStrings := []string{"example.com/lib.Equal"}
+	if got := GetStrings(MutantAPISwapNegationsKey); !cmp.Equal(got, wantStrings) {
+		t.Errorf(cmp.Diff(wantStrings, got))
+	}
+}
+
+func TestRules(t *testing.T) {
+	if err := Init([]string{"testdata/config3/.gremlins.yaml"}); err != nil {
+		t.Fatal(err)
+	}
+	defer viper.Reset()
+
+	want := []Rule{
+		{Name: "retry-zero", Pattern: "a.Retry(x)", Replacement: "a.Retry(0)", Enabled: true},
+		{Name: "no-timeout", Pattern: "context.WithTimeout(ctx, d)", Replacement: "context.WithCancel(ctx)", Enabled: false},
+	}
+	if got := GetRules(); !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
 func TestReset(t *testing.T) {
 	Set("test.key", true)
 
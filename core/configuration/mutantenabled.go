@@ -32,11 +32,66 @@ var mutationEnabled = map[mutator.Type]bool{
 	mutator.InvertLoopCtrl:           false,
 	mutator.InvertNegatives:          true,
 	mutator.RemoveSelfAssignments:    false,
+	mutator.StatementRemoval:         false,
+	mutator.ReturnValues:             false,
+	mutator.ErrorReturnNil:           false,
+	mutator.ErrorGuardRemoval:        false,
+	mutator.ErrorCheckNegation:       false,
+	mutator.ErrorUnwrap:              false,
+	mutator.NumericLiteral:           false,
+	mutator.StringLiteral:            false,
+	mutator.InvertBooleans:           false,
+	mutator.ForceConditions:          false,
+	mutator.RemoveNot:                false,
+	mutator.RemoveCase:               false,
+	mutator.EmptyCase:                false,
+	mutator.RemoveDefault:            false,
+	mutator.SliceBoundary:            false,
+	mutator.SliceBoundRemoval:        false,
+	mutator.IndexShift:               false,
+	mutator.InlineGoroutine:          false,
+	mutator.RemoveDefer:              false,
+	mutator.RemoveLock:               false,
+	mutator.RLockToLock:              false,
+	mutator.WaitGroupAdd:             false,
+	mutator.OnceDo:                   false,
+	mutator.ChannelBuffer:            false,
+	mutator.RemoveClose:              false,
+	mutator.SelectDefault:            false,
+	mutator.ContextBackground:        false,
+	mutator.ContextWrapRemoval:       false,
+	mutator.ArgumentSwap:             false,
+	mutator.RemoveAppend:             false,
+	mutator.RemoveDelete:             false,
+	mutator.RemoveMapWrite:           false,
+	mutator.FieldRemoval:             false,
+	mutator.APISwap:                  false,
+	mutator.RelationalReplacement:    false,
+	mutator.ArithmeticReplacement:    false,
+	mutator.LogicalReplacement:       false,
+	mutator.BuiltinLength:            false,
+	mutator.BuiltinMinMax:            false,
+	mutator.BuiltinCopy:              false,
+	mutator.RemoveRecover:            false,
+	mutator.RemovePanic:              false,
+	mutator.LoopSkip:                 false,
+	mutator.LoopOnce:                 false,
 }
 
 // IsDefaultEnabled returns the default enabled/disabled state of the mutation.
 // It gets the state from the table above that must be kept up to date when adding
 // new mutant types.
 func IsDefaultEnabled(mt mutator.Type) bool {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
 	return mutationEnabled[mt]
 }
+
+// SetDefaultEnabled sets the default enabled/disabled state of a mutator.Type
+// registered at runtime.
+func SetDefaultEnabled(mt mutator.Type, enabled bool) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	mutationEnabled[mt] = enabled
+}
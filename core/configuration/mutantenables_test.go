@@ -74,6 +74,182 @@ func TestMutantDefaultStatus(t *testing.T) {
 			mutantType: mutator.RemoveSelfAssignments,
 			expected:   false,
 		},
+		{
+			mutantType: mutator.StatementRemoval,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.ReturnValues,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.ErrorReturnNil,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.ErrorGuardRemoval,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.ErrorCheckNegation,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.ErrorUnwrap,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.NumericLiteral,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.StringLiteral,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.InvertBooleans,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.ForceConditions,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemoveNot,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemoveCase,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.EmptyCase,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemoveDefault,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.SliceBoundary,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.SliceBoundRemoval,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.IndexShift,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.InlineGoroutine,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemoveDefer,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemoveLock,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RLockToLock,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.WaitGroupAdd,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.OnceDo,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.ChannelBuffer,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemoveClose,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.SelectDefault,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.ContextBackground,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.ContextWrapRemoval,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.ArgumentSwap,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemoveAppend,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemoveDelete,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemoveMapWrite,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.FieldRemoval,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.APISwap,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RelationalReplacement,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.ArithmeticReplacement,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.LogicalReplacement,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.BuiltinLength,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.BuiltinMinMax,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.BuiltinCopy,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemoveRecover,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.RemovePanic,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.LoopSkip,
+			expected:   false,
+		},
+		{
+			mutantType: mutator.LoopOnce,
+			expected:   false,
+		},
 	}
 
 	for _, tc := range testCases {
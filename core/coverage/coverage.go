@@ -33,11 +33,17 @@ import (
 	"github.com/go-maxhub/gremlins/core/gomodule"
 )
 
+// RaceWarning is the header printed by the race detector on each race found.
+const RaceWarning = "WARNING: DATA RACE"
+
 // Result contains the Profile generated by the coverage and the time
 // it took to generate the coverage report.
+// Races holds the packages whose tests have data races even without any
+// mutation, when the race detector is enabled.
 type Result struct {
 	Profile Profile
 	Elapsed time.Duration
+	Races   []string
 }
 
 // Coverage is responsible for executing a Go test with coverage via the Run() method,
@@ -52,6 +58,7 @@ type Coverage struct {
 	buildTags       string
 	coverPkg        string
 	integrationMode bool
+	race            bool
 }
 
 // Option for the Coverage initialization.
@@ -70,6 +77,7 @@ func NewWithCmd(cmdContext execContext, workdir string, mod gomodule.GoModule, o
 	buildTags := configuration.Get[string](configuration.UnleashTagsKey)
 	coverPkg := configuration.Get[string](configuration.UnleashCoverPkgKey)
 	integrationMode := configuration.Get[bool](configuration.UnleashIntegrationMode)
+	race := configuration.Get[bool](configuration.UnleashRaceKey)
 
 	c := &Coverage{
 		cmdContext:      cmdContext,
@@ -80,6 +88,7 @@ func NewWithCmd(cmdContext execContext, workdir string, mod gomodule.GoModule, o
 		buildTags:       buildTags,
 		coverPkg:        coverPkg,
 		integrationMode: integrationMode,
+		race:            race,
 	}
 	for _, opt := range opts {
 		c = opt(c)
@@ -93,23 +102,29 @@ func NewWithCmd(cmdContext execContext, workdir string, mod gomodule.GoModule, o
 // Before executing the coverage, it downloads the go modules in a separate step.
 // This is done to avoid that the download phase impacts the execution time which
 // is later used as timeout for the mutant testing execution.
+// When the race detector is enabled, the coverage is gathered with it, so
+// that the packages whose tests fail only because of data races can be
+// told apart from the mutants.
 func (c *Coverage) Run() (Result, error) {
 	log.Infof("Gathering coverage... ")
 	_ = os.Chdir(c.mod.Root)
 	//if err := c.downloadModules(); err != nil {
 	//	return Result{}, fmt.Errorf("impossible to download modules: %w", err)
 	//}
-	elapsed, err := c.executeCoverage()
+	elapsed, races, err := c.executeCoverage()
 	if err != nil {
 		return Result{}, fmt.Errorf("impossible to executeCoverage coverage: %w", err)
 	}
 	log.Infof("done in %s\n", elapsed)
+	if len(races) > 0 {
+		log.Infof("Data races found without mutations in: %s\n", strings.Join(races, ", "))
+	}
 	profile, err := c.profile()
 	if err != nil {
 		return Result{}, fmt.Errorf("an error occurred while generating coverage profile: %w", err)
 	}
 
-	return Result{Profile: profile, Elapsed: elapsed}, nil
+	return Result{Profile: profile, Elapsed: elapsed, Races: races}, nil
 }
 
 func (c *Coverage) profile() (Profile, error) {
@@ -140,7 +155,7 @@ func (c *Coverage) downloadModules() error {
 	return cmd.Run()
 }
 
-func (c *Coverage) executeCoverage() (time.Duration, error) {
+func (c *Coverage) executeCoverage() (time.Duration, []string, error) {
 	args := []string{"test"}
 	if c.buildTags != "" {
 		args = append(args, "-tags", c.buildTags)
@@ -148,18 +163,56 @@ func (c *Coverage) executeCoverage() (time.Duration, error) {
 	if c.coverPkg != "" {
 		args = append(args, "-coverpkg", c.coverPkg)
 	}
+	if c.race {
+		args = append(args, "-race")
+	}
 
 	args = append(args, "-cover", "-coverprofile", c.filePath(), c.scanPath())
 	cmd := c.cmdContext("go", args...)
 
 	start := time.Now()
-	if out, err := cmd.CombinedOutput(); err != nil {
-		log.Infof("\n%s\n", string(out))
+	out, err := cmd.CombinedOutput()
+	elapsed := time.Since(start)
+	if err != nil {
+		races, ok := racyPackages(out)
+		if !c.race || !ok {
+			log.Infof("\n%s\n", string(out))
+
+			return 0, nil, err
+		}
+
+		return elapsed, races, nil
+	}
+
+	return elapsed, nil, nil
+}
 
-		return 0, err
+// racyPackages returns the packages whose tests failed with a data race,
+// as reported by the output of go test. It returns false if the tests of
+// any package failed without a data race.
+func racyPackages(out []byte) ([]string, bool) {
+	var races []string
+	race := false
+	for _, line := range strings.Split(string(out), "\n") {
+		if strings.Contains(line, RaceWarning) {
+			race = true
+
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 || (fields[0] != "ok" && fields[0] != "FAIL") {
+			continue
+		}
+		if fields[0] == "FAIL" {
+			if !race {
+				return nil, false
+			}
+			races = append(races, fields[1])
+		}
+		race = false
 	}
 
-	return time.Since(start), nil
+	return races, len(races) > 0
 }
 
 func (c *Coverage) scanPath() string {
@@ -188,6 +188,76 @@ func TestParseOutputFail(t *testing.T) {
 	}
 }
 
+func TestCoverageRace(t *testing.T) {
+	mod := gomodule.GoModule{
+		Name:       "example.com",
+		CallingDir: "path",
+	}
+
+	t.Run("it gathers the packages with data races", func(t *testing.T) {
+		viper.Set(configuration.UnleashRaceKey, true)
+		defer viper.Reset()
+		holder := &commandHolder{}
+		cov := coverage.NewWithCmd(fakeExecCommand(holder, "TestCoverageProcessRace"), "testdata/valid", mod)
+
+		got, err := cov.Run()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []string{"example.com/racy"}
+		if !cmp.Equal(got.Races, want) {
+			t.Error(cmp.Diff(want, got.Races))
+		}
+		if args := strings.Join(holder.events[0].args, " "); !strings.Contains(args, " -race ") {
+			t.Errorf("expected the race detector to be enabled, got %q", args)
+		}
+	})
+
+	t.Run("it fails if the tests fail without data races", func(t *testing.T) {
+		viper.Set(configuration.UnleashRaceKey, true)
+		defer viper.Reset()
+		cov := coverage.NewWithCmd(fakeExecCommand(nil, "TestCoverageProcessFailureAndRace"), "testdata/valid", mod)
+
+		if _, err := cov.Run(); err == nil {
+			t.Error("expected run to report an error")
+		}
+	})
+
+	t.Run("it fails on data races when the race detector is disabled", func(t *testing.T) {
+		cov := coverage.NewWithCmd(fakeExecCommand(nil, "TestCoverageProcessRace"), "testdata/valid", mod)
+
+		if _, err := cov.Run(); err == nil {
+			t.Error("expected run to report an error")
+		}
+	})
+}
+
+func TestCoverageProcessRace(_ *testing.T) {
+	if os.Getenv("GO_TEST_PROCESS") != "1" {
+		return
+	}
+	fmt.Println("ok  \texample.com/safe\t0.1s\tcoverage: 10.0% of statements")
+	fmt.Println("==================")
+	fmt.Println("WARNING: DATA RACE")
+	fmt.Println("--- FAIL: TestRacy (0.00s)")
+	fmt.Println("FAIL\texample.com/racy\t0.1s")
+	fmt.Println("FAIL")
+	os.Exit(1) // skipcq: RVV-A0003
+}
+
+func TestCoverageProcessFailureAndRace(_ *testing.T) {
+	if os.Getenv("GO_TEST_PROCESS") != "1" {
+		return
+	}
+	fmt.Println("WARNING: DATA RACE")
+	fmt.Println("FAIL\texample.com/racy\t0.1s")
+	fmt.Println("--- FAIL: TestBroken (0.00s)")
+	fmt.Println("FAIL\texample.com/broken\t0.1s")
+	fmt.Println("FAIL")
+	os.Exit(1) // skipcq: RVV-A0003
+}
+
 func TestCoverageProcessSuccess(_ *testing.T) {
 	if os.Getenv("GO_TEST_PROCESS") != "1" {
 		return
@@ -239,3 +309,21 @@ func fakeExecCommandFailure(run int) execContext {
 		return cmd
 	}
 }
+
+func fakeExecCommand(got *commandHolder, process string) execContext {
+	return func(command string, args ...string) *exec.Cmd {
+		if got != nil {
+			got.events = append(got.events, struct {
+				command string
+				args    []string
+			}{command: command, args: args})
+		}
+		cs := []string{"-test.run=" + process, "--", command}
+		cs = append(cs, args...)
+		// #nosec G204 - We are in tests, we don't care
+		cmd := exec.Command(os.Args[0], cs...)
+		cmd.Env = []string{"GO_TEST_PROCESS=1"}
+
+		return cmd
+	}
+}
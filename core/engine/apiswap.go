@@ -0,0 +1,215 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/go-maxhub/gremlins/core/configuration"
+	"github.com/go-maxhub/gremlins/core/mutator"
+)
+
+// defaultAPISwapPairs are the functions, builtins and methods that can be
+// interchanged with each other, identified by their fully qualified name
+// as given by types.Func.FullName.
+var defaultAPISwapPairs = [][]string{
+	{"strings.HasPrefix", "strings.HasSuffix"},
+	{"strings.TrimPrefix", "strings.TrimSuffix"},
+	{"strings.TrimLeft", "strings.TrimRight"},
+	{"strings.Index", "strings.LastIndex"},
+	{"strings.ToUpper", "strings.ToLower"},
+	{"bytes.HasPrefix", "bytes.HasSuffix"},
+	{"bytes.TrimPrefix", "bytes.TrimSuffix"},
+	{"math.Floor", "math.Ceil"},
+	{"math.Min", "math.Max"},
+	{"min", "max"},
+	{"(time.Time).Before", "(time.Time).After"},
+}
+
+// defaultAPISwapNegations are the functions returning a bool whose result
+// is negated.
+var defaultAPISwapNegations = []string{
+	"bytes.Equal",
+	"strings.EqualFold",
+	"reflect.DeepEqual",
+}
+
+// apiSwapTable holds the replacements of the API_SWAP mutator, merging the
+// defaults with the ones from the configuration.
+type apiSwapTable struct {
+	swaps     map[string][]string
+	negations map[string]bool
+}
+
+// newAPISwapTable builds the apiSwapTable. Each pair of the configuration
+// may list more than two names, which are then all interchangeable. Since
+// the mutation renames the called function, the names of a pair must share
+// the package or the receiver, otherwise the pair is ignored.
+//
+// When BUILTIN_MIN_MAX is enabled, the swaps between the min and max
+// builtins are left to it, so that the same mutant isn't found twice.
+func newAPISwapTable() apiSwapTable {
+	t := apiSwapTable{
+		swaps:     make(map[string][]string),
+		negations: make(map[string]bool),
+	}
+	pairs := append([][]string{}, defaultAPISwapPairs...)
+	pairs = append(pairs, configuration.GetStringLists(configuration.MutantAPISwapPairsKey)...)
+	minMax := configuration.Get[bool](configuration.MutantTypeEnabledKey(mutator.BuiltinMinMax))
+	for _, pair := range pairs {
+		if len(pair) < 2 || !sameQualifier(pair) {
+			continue
+		}
+		for _, from := range pair {
+			for _, to := range pair {
+				if minMax && isMinMax(from) && isMinMax(to) {
+					continue
+				}
+				if from != to && !contains(t.swaps[from], to) {
+					t.swaps[from] = append(t.swaps[from], to)
+				}
+			}
+		}
+	}
+	negations := append([]string{}, defaultAPISwapNegations...)
+	negations = append(negations, configuration.GetStrings(configuration.MutantAPISwapNegationsKey)...)
+	for _, name := range negations {
+		t.negations[name] = true
+	}
+
+	return t
+}
+
+// findAPISwaps replaces the calls to the functions in the apiSwapTable with
+// the calls to their counterparts, and negates the results of the calls to
+// the functions to be negated.
+//
+// The called functions are resolved by the type checker, so that a local
+// identifier named after a package is never mistaken for it.
+func findAPISwaps(n ast.Node, c *cursor) []NodeMutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+	id, name := calleeName(c.info, call)
+	if id == nil {
+		return nil
+	}
+
+	var mutations []NodeMutation
+	for _, to := range c.apiSwaps.swaps[name] {
+		sel := unqualified(to)
+		mutations = append(mutations, NodeMutation{
+			Pos:  call.Pos(),
+			Desc: to,
+			Mutate: func() func() {
+				orig := id.Name
+				id.Name = sel
+
+				return func() {
+					id.Name = orig
+				}
+			},
+		})
+	}
+	if c.apiSwaps.negations[name] {
+		parent := c.parent()
+		not := &ast.UnaryExpr{OpPos: call.Pos(), Op: token.NOT, X: call}
+		if _, ok := parent.(*ast.ExprStmt); !ok && canReplace(parent, call, not) {
+			mutations = append(mutations, NodeMutation{
+				Pos:  call.Pos(),
+				Desc: "!" + name,
+				Mutate: func() func() {
+					return replaceNode(parent, call, not)
+				},
+			})
+		}
+	}
+
+	return mutations
+}
+
+// calleeName returns the identifier of the function called by the
+// expression, together with its fully qualified name. It returns a nil
+// identifier if the call cannot be resolved to a function or a builtin.
+func calleeName(info *types.Info, call *ast.CallExpr) (*ast.Ident, string) {
+	var id *ast.Ident
+	switch fun := unparen(call.Fun).(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	default:
+		return nil, ""
+	}
+	if info == nil {
+		return nil, ""
+	}
+	switch obj := info.Uses[id].(type) {
+	case *types.Func:
+		return id, obj.FullName()
+	case *types.Builtin:
+		return id, obj.Name()
+	}
+
+	return nil, ""
+}
+
+func isMinMax(name string) bool {
+	return name == "min" || name == "max"
+}
+
+func sameQualifier(names []string) bool {
+	q, _ := splitQualified(names[0])
+	for _, name := range names[1:] {
+		if nq, _ := splitQualified(name); nq != q {
+			return false
+		}
+	}
+
+	return true
+}
+
+func unqualified(name string) string {
+	_, n := splitQualified(name)
+
+	return n
+}
+
+// splitQualified splits a fully qualified name, such as strings.HasPrefix
+// or (time.Time).Before, in its qualifier and its name.
+func splitQualified(name string) (string, string) {
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return "", name
+	}
+
+	return name[:i], name[i+1:]
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// findArgumentSwaps swaps, one pair at a time, the adjacent arguments of a
+// call that have the same type, such as `copy(dst, src)`.
+//
+// The arguments whose type is unknown are skipped, as well as the pairs of
+// identical expressions and the calls spreading a slice with `...`.
+func findArgumentSwaps(n ast.Node, c *cursor) []NodeMutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) < 2 || call.Ellipsis.IsValid() || isConversion(c.info, call) {
+		return nil
+	}
+
+	var mutations []NodeMutation
+	for i := 0; i < len(call.Args)-1; i++ {
+		x, y := call.Args[i], call.Args[i+1]
+		tx, ty := typeOf(c.info, x), typeOf(c.info, y)
+		if tx == nil || ty == nil || !types.Identical(tx, ty) {
+			continue
+		}
+		if types.ExprString(x) == types.ExprString(y) {
+			continue
+		}
+		i := i
+		mutations = append(mutations, NodeMutation{
+			Pos:  x.Pos(),
+			Desc: types.ExprString(x) + ", " + types.ExprString(y),
+			Mutate: func() func() {
+				call.Args[i], call.Args[i+1] = call.Args[i+1], call.Args[i]
+
+				return func() {
+					call.Args[i], call.Args[i+1] = call.Args[i+1], call.Args[i]
+				}
+			},
+		})
+	}
+
+	return mutations
+}
+
+// isConversion tells if the call expression is a type conversion.
+func isConversion(info *types.Info, call *ast.CallExpr) bool {
+	if info == nil {
+		return false
+	}
+	tv, ok := info.Types[call.Fun]
+
+	return ok && tv.IsType()
+}
@@ -0,0 +1,176 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// findInvertBooleans flips the predeclared true and false identifiers.
+func findInvertBooleans(n ast.Node, c *cursor) []NodeMutation {
+	id, ok := n.(*ast.Ident)
+	if !ok || !isBoolConst(c.info, id) {
+		return nil
+	}
+	value := "true"
+	if id.Name == "true" {
+		value = "false"
+	}
+
+	return []NodeMutation{{
+		Pos: id.Pos(),
+		Mutate: func() func() {
+			orig := id.Name
+			id.Name = value
+
+			return func() {
+				id.Name = orig
+			}
+		},
+	}}
+}
+
+// findForceConditions replaces the conditions of the if and for statements
+// with true and false, so that the guarded code is always or never run.
+//
+// If the condition uses a variable declared by the init statement, such as
+// `if _, ok := m[k]; ok`, it is kept as `ok || true` and `ok && false`, so
+// that the variable is still used and the mutant compiles.
+func findForceConditions(n ast.Node, c *cursor) []NodeMutation {
+	var cond *ast.Expr
+	var init ast.Stmt
+	switch s := n.(type) {
+	case *ast.IfStmt:
+		cond, init = &s.Cond, s.Init
+	case *ast.ForStmt:
+		cond, init = &s.Cond, s.Init
+	default:
+		return nil
+	}
+	if *cond == nil {
+		return nil
+	}
+	keep := usesAny(*cond, definedNames(init))
+
+	var mutations []NodeMutation
+	for _, value := range []string{"true", "false"} {
+		if id, ok := unparen(*cond).(*ast.Ident); ok && id.Name == value && isBoolConst(c.info, id) {
+			continue
+		}
+		var repl ast.Expr = ast.NewIdent(value)
+		if keep {
+			op := token.LOR
+			if value == "false" {
+				op = token.LAND
+			}
+			repl = &ast.BinaryExpr{X: parenthesize(*cond), Op: op, Y: repl}
+		}
+		mutations = append(mutations, NodeMutation{
+			Pos: (*cond).Pos(),
+			Mutate: func() func() {
+				orig := *cond
+				*cond = repl
+
+				return func() {
+					*cond = orig
+				}
+			},
+		})
+	}
+
+	return mutations
+}
+
+// definedNames returns the names of the variables declared by a short
+// variable declaration, if s is one.
+func definedNames(s ast.Stmt) map[string]bool {
+	assign, ok := s.(*ast.AssignStmt)
+	if !ok || assign.Tok != token.DEFINE {
+		return nil
+	}
+	names := make(map[string]bool, len(assign.Lhs))
+	for _, lhs := range assign.Lhs {
+		if id, ok := lhs.(*ast.Ident); ok && id.Name != "_" {
+			names[id.Name] = true
+		}
+	}
+
+	return names
+}
+
+// usesAny tells if the node refers to any of the names.
+func usesAny(e ast.Node, names map[string]bool) bool {
+	if len(names) == 0 {
+		return false
+	}
+	found := false
+	ast.Inspect(e, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && names[id.Name] {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// parenthesize wraps the binary expressions in parentheses, so that they
+// can be used as the operand of another one.
+func parenthesize(e ast.Expr) ast.Expr {
+	if _, ok := e.(*ast.BinaryExpr); ok {
+		return &ast.ParenExpr{X: e}
+	}
+
+	return e
+}
+
+// findRemoveNots removes the logical negations, replacing the expression
+// with its operand.
+func findRemoveNots(n ast.Node, c *cursor) []NodeMutation {
+	u, ok := n.(*ast.UnaryExpr)
+	if !ok || u.Op != token.NOT {
+		return nil
+	}
+	parent := c.parent()
+
+	return []NodeMutation{{
+		Pos: u.OpPos,
+		Mutate: func() func() {
+			return replaceNode(parent, u, u.X)
+		},
+	}}
+}
+
+// isBoolConst tells if the identifier is one of the predeclared true and
+// false constants, and not a shadowing declaration.
+func isBoolConst(info *types.Info, id *ast.Ident) bool {
+	if id.Name != "true" && id.Name != "false" {
+		return false
+	}
+	if info == nil {
+		return true
+	}
+	if _, ok := info.Defs[id]; ok {
+		return false
+	}
+	obj, ok := info.Uses[id]
+
+	return !ok || obj == types.Universe.Lookup(id.Name)
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// findBuiltinLengths replaces the calls to len and cap with the call plus
+// and minus one, so that `len(s)` becomes `len(s)-1` and `len(s)+1`.
+// The lengths of the array types are left alone, since they must stay
+// constant and positive.
+func findBuiltinLengths(n ast.Node, c *cursor) []NodeMutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || (!isBuiltin(c.info, call, "len") && !isBuiltin(c.info, call, "cap")) {
+		return nil
+	}
+	if c.inArrayLen(call) {
+		return nil
+	}
+	parent := c.parent()
+
+	var mutations []NodeMutation
+	for _, op := range []token.Token{token.SUB, token.ADD} {
+		if op == token.SUB && isConstZero(c.info, call) {
+			continue
+		}
+		var repl ast.Expr = &ast.BinaryExpr{X: call, Op: op, Y: &ast.BasicLit{Kind: token.INT, Value: "1"}}
+		if needsParens(parent) {
+			repl = &ast.ParenExpr{X: repl}
+		}
+		mutations = append(mutations, NodeMutation{
+			Pos:  call.Pos(),
+			Desc: types.ExprString(repl),
+			Mutate: func() func() {
+				return replaceNode(parent, call, repl)
+			},
+		})
+	}
+
+	return mutations
+}
+
+// findBuiltinMinMaxes swaps the calls to the min and max builtins.
+func findBuiltinMinMaxes(n ast.Node, c *cursor) []NodeMutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+	var to string
+	switch {
+	case isBuiltin(c.info, call, "min"):
+		to = "max"
+	case isBuiltin(c.info, call, "max"):
+		to = "min"
+	default:
+		return nil
+	}
+	id := unparen(call.Fun).(*ast.Ident)
+
+	return []NodeMutation{{
+		Pos:  call.Pos(),
+		Desc: to,
+		Mutate: func() func() {
+			orig := id.Name
+			id.Name = to
+
+			return func() {
+				id.Name = orig
+			}
+		},
+	}}
+}
+
+// findBuiltinCopies drops the last element of the source of the calls to
+// copy, so that `copy(dst, src)` becomes `copy(dst, src[:len(src)-1])`.
+// Since the source is evaluated twice, only the identifiers and the
+// selectors are truncated.
+func findBuiltinCopies(n ast.Node, c *cursor) []NodeMutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 || call.Ellipsis.IsValid() || !isBuiltin(c.info, call, "copy") {
+		return nil
+	}
+	src := call.Args[1]
+	if !isSimpleOperand(src) {
+		return nil
+	}
+	length := &ast.CallExpr{Fun: ast.NewIdent("len"), Args: []ast.Expr{src}}
+	repl := &ast.SliceExpr{
+		X:    src,
+		High: &ast.BinaryExpr{X: length, Op: token.SUB, Y: &ast.BasicLit{Kind: token.INT, Value: "1"}},
+	}
+
+	return []NodeMutation{{
+		Pos:  call.Pos(),
+		Desc: types.ExprString(repl),
+		Mutate: func() func() {
+			orig := call.Args[1]
+			call.Args[1] = repl
+
+			return func() {
+				call.Args[1] = orig
+			}
+		},
+	}}
+}
+
+// needsParens tells if an arithmetic expression replacing a child of the
+// parent must be parenthesized to keep its precedence.
+func needsParens(parent ast.Node) bool {
+	switch p := parent.(type) {
+	case *ast.UnaryExpr:
+		return true
+	case *ast.BinaryExpr:
+		return p.Op.Precedence() >= token.ADD.Precedence()
+	}
+
+	return false
+}
+
+// isSimpleOperand tells if the expression is an identifier or a chain of
+// selectors, which can be evaluated more than once.
+func isSimpleOperand(e ast.Expr) bool {
+	switch e := unparen(e).(type) {
+	case *ast.Ident:
+		return true
+	case *ast.SelectorExpr:
+		return isSimpleOperand(e.X)
+	}
+
+	return false
+}
@@ -0,0 +1,215 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
+// findRemoveCases removes, one at a time, the case clauses of switch, type
+// switch and select statements. The default clause is left to
+// findRemoveDefaults.
+//
+// The clauses of the type switches holding the only uses of the bound
+// variable are skipped, since the variable would be left unused. The same
+// goes for findEmptyCases and findRemoveDefaults.
+func findRemoveCases(n ast.Node, _ *cursor) []NodeMutation {
+	body := clausesBody(n)
+	if body == nil {
+		return nil
+	}
+
+	var mutations []NodeMutation
+	for i, clause := range body.List {
+		if isDefaultClause(clause) || !boundVarUsedElsewhere(n, i) {
+			continue
+		}
+		mutations = append(mutations, NodeMutation{
+			Pos:    clause.Pos(),
+			Desc:   caseLabel(clause),
+			Mutate: removeClause(body, i),
+		})
+	}
+
+	return mutations
+}
+
+// findEmptyCases drops, one at a time, the statements of the clauses of
+// switch, type switch and select statements. The select clauses declaring
+// variables, such as `case v := <-ch`, are skipped, since they would be
+// left unused.
+func findEmptyCases(n ast.Node, _ *cursor) []NodeMutation {
+	body := clausesBody(n)
+	if body == nil {
+		return nil
+	}
+
+	var mutations []NodeMutation
+	for i, clause := range body.List {
+		stmts := clauseBody(clause)
+		if len(*stmts) == 0 || declaresVars(clause) || !boundVarUsedElsewhere(n, i) {
+			continue
+		}
+		mutations = append(mutations, NodeMutation{
+			Pos:  clause.Pos(),
+			Desc: caseLabel(clause),
+			Mutate: func() func() {
+				orig := *stmts
+				*stmts = nil
+
+				return func() {
+					*stmts = orig
+				}
+			},
+		})
+	}
+
+	return mutations
+}
+
+// findRemoveDefaults removes the default clause of switch, type switch and
+// select statements.
+func findRemoveDefaults(n ast.Node, _ *cursor) []NodeMutation {
+	body := clausesBody(n)
+	if body == nil {
+		return nil
+	}
+	for i, clause := range body.List {
+		if isDefaultClause(clause) && boundVarUsedElsewhere(n, i) {
+			return []NodeMutation{{
+				Pos:    clause.Pos(),
+				Mutate: removeClause(body, i),
+			}}
+		}
+	}
+
+	return nil
+}
+
+// clausesBody returns the block holding the clauses of switch, type switch
+// and select statements, or nil for any other ast.Node.
+func clausesBody(n ast.Node) *ast.BlockStmt {
+	switch s := n.(type) {
+	case *ast.SwitchStmt:
+		return s.Body
+	case *ast.TypeSwitchStmt:
+		return s.Body
+	case *ast.SelectStmt:
+		return s.Body
+	}
+
+	return nil
+}
+
+// boundVarUsedElsewhere tells if the variable bound by the type switch n,
+// such as v in `switch v := x.(type)`, is used by a clause other than the
+// i-th one. It returns true if n binds no variable.
+func boundVarUsedElsewhere(n ast.Node, i int) bool {
+	s, ok := n.(*ast.TypeSwitchStmt)
+	if !ok {
+		return true
+	}
+	names := definedNames(s.Assign)
+	if len(names) == 0 {
+		return true
+	}
+	for j, clause := range s.Body.List {
+		if j != i && usesAny(clause, names) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// declaresVars tells if the clause is a select clause declaring variables
+// other than the blank identifier.
+func declaresVars(clause ast.Stmt) bool {
+	c, ok := clause.(*ast.CommClause)
+
+	return ok && len(definedNames(c.Comm)) > 0
+}
+
+// removeClause returns the function that removes the i-th clause of body.
+func removeClause(body *ast.BlockStmt, i int) func() func() {
+	return func() func() {
+		orig := body.List
+		body.List = make([]ast.Stmt, 0, len(orig)-1)
+		body.List = append(body.List, orig[:i]...)
+		body.List = append(body.List, orig[i+1:]...)
+
+		return func() {
+			body.List = orig
+		}
+	}
+}
+
+func clauseBody(clause ast.Stmt) *[]ast.Stmt {
+	switch c := clause.(type) {
+	case *ast.CaseClause:
+		return &c.Body
+	case *ast.CommClause:
+		return &c.Body
+	}
+
+	return new([]ast.Stmt)
+}
+
+func isDefaultClause(clause ast.Stmt) bool {
+	switch c := clause.(type) {
+	case *ast.CaseClause:
+		return c.List == nil
+	case *ast.CommClause:
+		return c.Comm == nil
+	}
+
+	return false
+}
+
+// caseLabel returns the header of the clause as written in the source
+// code, such as `case a, b` or `case v := <-ch`.
+func caseLabel(clause ast.Stmt) string {
+	if isDefaultClause(clause) {
+		return "default"
+	}
+	switch c := clause.(type) {
+	case *ast.CaseClause:
+		return "case " + exprList(c.List)
+	case *ast.CommClause:
+		switch s := c.Comm.(type) {
+		case *ast.SendStmt:
+			return "case " + types.ExprString(s.Chan) + " <- " + types.ExprString(s.Value)
+		case *ast.ExprStmt:
+			return "case " + types.ExprString(s.X)
+		case *ast.AssignStmt:
+			return "case " + exprList(s.Lhs) + " " + s.Tok.String() + " " + exprList(s.Rhs)
+		}
+	}
+
+	return "case"
+}
+
+func exprList(list []ast.Expr) string {
+	s := make([]string, 0, len(list))
+	for _, e := range list {
+		s = append(s, types.ExprString(e))
+	}
+
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// findChannelBuffers changes the buffer size n of the channels created with
+// make(chan T, n) to 0, making them unbuffered, and to n+1.
+func findChannelBuffers(n ast.Node, c *cursor) []NodeMutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 || !isBuiltin(c.info, call, "make") {
+		return nil
+	}
+	if t := typeOf(c.info, call.Args[0]); t == nil {
+		return nil
+	} else if _, ok := t.Underlying().(*types.Chan); !ok {
+		return nil
+	}
+	size := &call.Args[1]
+
+	var repls []ast.Expr
+	if !isConstZero(c.info, *size) {
+		repls = append(repls, &ast.BasicLit{Kind: token.INT, Value: "0"})
+	}
+	repls = append(repls, &ast.BinaryExpr{X: *size, Op: token.ADD, Y: &ast.BasicLit{Kind: token.INT, Value: "1"}})
+
+	var mutations []NodeMutation
+	for _, repl := range repls {
+		repl := repl
+		mutations = append(mutations, NodeMutation{
+			Pos: (*size).Pos(),
+			Mutate: func() func() {
+				orig := *size
+				*size = repl
+
+				return func() {
+					*size = orig
+				}
+			},
+		})
+	}
+
+	return mutations
+}
+
+// findRemoveCloses removes the calls to close, deferred or not.
+func findRemoveCloses(n ast.Node, c *cursor) []NodeMutation {
+	var call *ast.CallExpr
+	switch s := n.(type) {
+	case *ast.ExprStmt:
+		call, _ = s.X.(*ast.CallExpr)
+	case *ast.DeferStmt:
+		call = s.Call
+	}
+	if call == nil || !isBuiltin(c.info, call, "close") {
+		return nil
+	}
+	parent := c.parent()
+	stmt := n.(ast.Stmt)
+
+	return []NodeMutation{{
+		Pos: stmt.Pos(),
+		Mutate: func() func() {
+			return removeStmt(parent, stmt)
+		},
+	}}
+}
+
+// findSelectDefaults adds an empty default clause to the select statements
+// that don't have one, making them non-blocking. Removing an existing
+// default is left to findRemoveDefaults.
+func findSelectDefaults(n ast.Node, _ *cursor) []NodeMutation {
+	s, ok := n.(*ast.SelectStmt)
+	if !ok {
+		return nil
+	}
+	for _, clause := range s.Body.List {
+		if isDefaultClause(clause) {
+			return nil
+		}
+	}
+
+	return []NodeMutation{{
+		Pos: s.Pos(),
+		Mutate: func() func() {
+			orig := s.Body.List
+			s.Body.List = append(orig[:len(orig):len(orig)], &ast.CommClause{Colon: s.Body.Rbrace})
+
+			return func() {
+				s.Body.List = orig
+			}
+		},
+	}}
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// findRemoveAppends replaces the calls to append on the right side of an
+// assignment with the slice being appended to, so that `s = append(s, x)`
+// becomes `s = s`.
+func findRemoveAppends(n ast.Node, c *cursor) []NodeMutation {
+	assign, ok := n.(*ast.AssignStmt)
+	if !ok {
+		return nil
+	}
+
+	var mutations []NodeMutation
+	for i, rhs := range assign.Rhs {
+		call, ok := unparen(rhs).(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 || !isBuiltin(c.info, call, "append") {
+			continue
+		}
+		i := i
+		mutations = append(mutations, NodeMutation{
+			Pos: call.Pos(),
+			Mutate: func() func() {
+				orig := assign.Rhs[i]
+				assign.Rhs[i] = call.Args[0]
+
+				return func() {
+					assign.Rhs[i] = orig
+				}
+			},
+		})
+	}
+
+	return mutations
+}
+
+// findRemoveDeletes removes the calls to delete.
+func findRemoveDeletes(n ast.Node, c *cursor) []NodeMutation {
+	stmt, ok := n.(*ast.ExprStmt)
+	if !ok {
+		return nil
+	}
+	call, ok := stmt.X.(*ast.CallExpr)
+	if !ok || !isBuiltin(c.info, call, "delete") {
+		return nil
+	}
+	parent := c.parent()
+	if !isStmtList(parent) {
+		return nil
+	}
+
+	return []NodeMutation{{
+		Pos: stmt.Pos(),
+		Mutate: func() func() {
+			return removeStmt(parent, stmt)
+		},
+	}}
+}
+
+// findRemoveMapWrites removes the assignments to a map entry, such as
+// `m[k] = v` and `m[k] += v`.
+func findRemoveMapWrites(n ast.Node, c *cursor) []NodeMutation {
+	assign, ok := n.(*ast.AssignStmt)
+	if !ok || assign.Tok == token.DEFINE || len(assign.Lhs) != 1 {
+		return nil
+	}
+	ix, ok := unparen(assign.Lhs[0]).(*ast.IndexExpr)
+	if !ok || !isMap(typeOf(c.info, ix.X)) {
+		return nil
+	}
+	parent := c.parent()
+	if !isStmtList(parent) {
+		return nil
+	}
+
+	return []NodeMutation{{
+		Pos: assign.Pos(),
+		Mutate: func() func() {
+			return removeStmt(parent, assign)
+		},
+	}}
+}
+
+func isMap(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	_, ok := t.Underlying().(*types.Map)
+
+	return ok
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+)
+
+// findInlineGoroutines turns the go statements into plain calls, so that
+// the function runs synchronously.
+//
+// The mutants may hang the tests, for example when the goroutine waits on
+// a channel fed by the caller, and are then reported as timed out.
+func findInlineGoroutines(n ast.Node, c *cursor) []NodeMutation {
+	stmt, ok := n.(*ast.GoStmt)
+	if !ok {
+		return nil
+	}
+	parent := c.parent()
+
+	return []NodeMutation{{
+		Pos: stmt.Pos(),
+		Mutate: func() func() {
+			return replaceNode(parent, stmt, &ast.ExprStmt{X: stmt.Call})
+		},
+	}}
+}
+
+// findRemoveDefers removes the defer statements.
+func findRemoveDefers(n ast.Node, c *cursor) []NodeMutation {
+	stmt, ok := n.(*ast.DeferStmt)
+	if !ok {
+		return nil
+	}
+	parent := c.parent()
+
+	return []NodeMutation{{
+		Pos: stmt.Pos(),
+		Mutate: func() func() {
+			return removeStmt(parent, stmt)
+		},
+	}}
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/types"
+	"path"
+	"strconv"
+)
+
+// findContextBackgrounds replaces, one at a time, the arguments passed to
+// context.Context parameters with context.Background(), so that the callee
+// loses the cancellation and the values of the caller.
+//
+// Only the files that already import the context package are mutated, so
+// that the import doesn't have to be added.
+func findContextBackgrounds(n ast.Node, c *cursor) []NodeMutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || call.Ellipsis.IsValid() || len(call.Args) == 0 {
+		return nil
+	}
+	sig, ok := typeOf(c.info, call.Fun).(*types.Signature)
+	if !ok {
+		return nil
+	}
+	pkg, ok := importName(c.file, "context")
+	if !ok {
+		return nil
+	}
+
+	var mutations []NodeMutation
+	for i := range call.Args {
+		if i >= sig.Params().Len() || (sig.Variadic() && i >= sig.Params().Len()-1) {
+			break
+		}
+		arg := &call.Args[i]
+		if !isContext(sig.Params().At(i).Type()) || isBackground(c.info, *arg) {
+			continue
+		}
+		mutations = append(mutations, NodeMutation{
+			Pos: (*arg).Pos(),
+			Mutate: func() func() {
+				orig := *arg
+				*arg = &ast.CallExpr{Fun: pkgSelector(pkg, "Background")}
+
+				return func() {
+					*arg = orig
+				}
+			},
+		})
+	}
+
+	return mutations
+}
+
+// findContextWrapRemovals removes the derivation of cancellable contexts,
+// such as `ctx, cancel := context.WithTimeout(parent, d)`, which becomes
+// `ctx, cancel := parent, func() {}`.
+func findContextWrapRemovals(n ast.Node, c *cursor) []NodeMutation {
+	assign, ok := n.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+		return nil
+	}
+	call, ok := unparen(assign.Rhs[0]).(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 || !isPkgFunc(c.info, call, "context", "WithCancel", "WithTimeout", "WithDeadline") {
+		return nil
+	}
+
+	return []NodeMutation{{
+		Pos: call.Pos(),
+		Mutate: func() func() {
+			orig := assign.Rhs
+			// The positions keep the empty function on a single line.
+			noop := &ast.FuncLit{
+				Type: &ast.FuncType{Func: call.End(), Params: &ast.FieldList{}},
+				Body: &ast.BlockStmt{Lbrace: call.End(), Rbrace: call.End()},
+			}
+			assign.Rhs = []ast.Expr{call.Args[0], noop}
+
+			return func() {
+				assign.Rhs = orig
+			}
+		},
+	}}
+}
+
+// isContext tells if t is context.Context.
+func isContext(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Pkg().Path() == "context" && named.Obj().Name() == "Context"
+}
+
+// isBackground tells if the expression is already an empty context.
+func isBackground(info *types.Info, e ast.Expr) bool {
+	call, ok := unparen(e).(*ast.CallExpr)
+
+	return ok && isPkgFunc(info, call, "context", "Background", "TODO")
+}
+
+// importName returns the name by which the file refers to the package with
+// the given import path. It returns false if the package is not imported,
+// or it is imported for its side effects only.
+func importName(file *ast.File, importPath string) (string, bool) {
+	for _, imp := range file.Imports {
+		if p, err := strconv.Unquote(imp.Path.Value); err != nil || p != importPath {
+			continue
+		}
+		if imp.Name == nil {
+			return path.Base(importPath), true
+		}
+		if imp.Name.Name == "_" {
+			return "", false
+		}
+
+		return imp.Name.Name, true
+	}
+
+	return "", false
+}
+
+// pkgSelector returns the expression referring to the name exported by the
+// package imported as pkg. Dot imports are referred to directly.
+func pkgSelector(pkg, name string) ast.Expr {
+	if pkg == "." {
+		return ast.NewIdent(name)
+	}
+
+	return &ast.SelectorExpr{X: ast.NewIdent(pkg), Sel: ast.NewIdent(name)}
+}
@@ -22,7 +22,9 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"io/fs"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"strings"
@@ -48,7 +50,13 @@ type Engine struct {
 	jDealer      ExecutorDealer
 	codeData     CodeData
 	mutantStream chan mutator.Mutator
+	importer     types.Importer
 	module       gomodule.GoModule
+	apiSwaps     apiSwapTable
+	rules        []rule
+	hom          HigherOrder
+	extreme      bool
+	typed        bool
 }
 
 // CodeData is used to check if the mutant should be executed.
@@ -71,6 +79,7 @@ func New(mod gomodule.GoModule, codeData CodeData, jDealer ExecutorDealer, opts
 		jDealer:  jDealer,
 		codeData: codeData,
 		fs:       dirFS,
+		importer: newImporter(filepath.Join(mod.Root, mod.CallingDir)),
 	}
 	for _, opt := range opts {
 		mut = opt(mut)
@@ -91,18 +100,48 @@ func WithDirFs(dirFS fs.FS) Option {
 // Run executes the mutation testing.
 //
 // It walks the fs.FS provided and checks every .go file which is not a test.
+// The files are grouped by directory, so that the type information of each
+// package can be gathered before looking for mutations.
 // For each file it will scan for tokenMutations and gather all the mutants found.
+// In the extreme mode, only the bodies of the functions are mutated.
 func (mu *Engine) Run(ctx context.Context) report.Results {
 	mu.mutantStream = make(chan mutator.Mutator)
+	mu.apiSwaps = newAPISwapTable()
+	mu.rules = newRules()
+	mu.extreme = configuration.Get[bool](configuration.UnleashExtremeKey)
+	mu.typed = mu.extreme || needsTypes()
 	go func() {
 		defer close(mu.mutantStream)
+		var dirs []string
+		pkgFiles := make(map[string][]string)
 		_ = fs.WalkDir(mu.fs, ".", func(path string, d fs.DirEntry, err error) error {
 			if filepath.Ext(path) == ".go" && !strings.HasSuffix(path, "_test.go") {
-				mu.runOnFile(path)
+				dir := filepath.Dir(path)
+				if _, ok := pkgFiles[dir]; !ok {
+					dirs = append(dirs, dir)
+				}
+				pkgFiles[dir] = append(pkgFiles[dir], path)
 			}
 
 			return nil
 		})
+		// The higher-order mutants are combined once all the first-order
+		// ones have been found.
+		combine := mu.hom.enabled() && !mu.extreme
+		var found []mutator.Mutator
+		for _, dir := range dirs {
+			mutants := mu.runOnPackage(pkgFiles[dir])
+			if combine {
+				found = append(found, mutants...)
+
+				continue
+			}
+			mu.send(mutants)
+		}
+		if combine {
+			rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+			mu.send(mu.hom.combine(found, rnd))
+		}
 	}()
 
 	start := time.Now()
@@ -113,41 +152,174 @@ func (mu *Engine) Run(ctx context.Context) report.Results {
 	return res
 }
 
-func (mu *Engine) runOnFile(fileName string) {
-	src, _ := mu.fs.Open(fileName)
+func (mu *Engine) send(mutants []mutator.Mutator) {
+	for _, m := range mutants {
+		mu.mutantStream <- m
+	}
+}
+
+func (mu *Engine) runOnPackage(fileNames []string) []mutator.Mutator {
 	set := token.NewFileSet()
-	file, _ := parser.ParseFile(set, fileName, src, parser.ParseComments)
-	_ = src.Close()
+	files := make(map[string]*ast.File, len(fileNames))
+	byPkg := make(map[string][]*ast.File)
+	for _, fileName := range fileNames {
+		src, _ := mu.fs.Open(fileName)
+		file, _ := parser.ParseFile(set, fileName, src, parser.ParseComments)
+		_ = src.Close()
+		if file == nil {
+			continue
+		}
+		files[fileName] = file
+		byPkg[file.Name.Name] = append(byPkg[file.Name.Name], file)
+	}
 
-	ast.Inspect(file, func(node ast.Node) bool {
-		n, ok := NewTokenNode(node)
+	infos := make(map[string]*types.Info, len(byPkg))
+	if mu.typed {
+		for name, pkgFiles := range byPkg {
+			infos[name] = typeCheck(mu.importer, set, pkgFiles)
+		}
+	}
+
+	var mutants []mutator.Mutator
+	for _, fileName := range fileNames {
+		file, ok := files[fileName]
 		if !ok {
+			continue
+		}
+		mutants = append(mutants, mu.runOnFile(fileName, set, file, infos[file.Name.Name])...)
+	}
+
+	return mutants
+}
+
+func (mu *Engine) runOnFile(fileName string, set *token.FileSet, file *ast.File, info *types.Info) []mutator.Mutator {
+	// The mutants are returned only once the walk is complete, because the
+	// NodeMutator rewrites the same AST that is being walked.
+	var mutants []mutator.Mutator
+	c := &cursor{file: file, info: info, apiSwaps: mu.apiSwaps}
+	ast.Inspect(file, func(node ast.Node) bool {
+		if node == nil {
+			c.stack = c.stack[:len(c.stack)-1]
+
+			return true
+		}
+		if mu.extreme {
+			mutants = append(mutants, mu.newNodeMutants(fileName, set, file, mutator.Extreme, findExtremeMutations(node, c), nil)...)
+			c.stack = append(c.stack, node)
+
 			return true
 		}
-		mu.findMutations(fileName, set, file, n)
+		if n, ok := NewTokenNode(node); ok {
+			mutants = append(mutants, mu.findMutations(fileName, set, file, n)...)
+		}
+		mutants = append(mutants, mu.findNodeMutations(fileName, set, file, node, c)...)
+		c.stack = append(c.stack, node)
 
 		return true
 	})
+
+	return mutants
 }
 
-func (mu *Engine) findMutations(fileName string, set *token.FileSet, file *ast.File, node *NodeToken) {
-	mutantTypes, ok := TokenMutantType[node.Tok()]
-	if !ok {
-		return
+func (mu *Engine) findMutations(fileName string, set *token.FileSet, file *ast.File, node *NodeToken) []mutator.Mutator {
+	mutantTypes := enabledTokenTypes(node.Tok())
+	if len(mutantTypes) == 0 {
+		return nil
 	}
 
+	var mutants []mutator.Mutator
 	pkg := mu.pkgName(fileName, file.Name.Name)
 	for _, mt := range mutantTypes {
-		if !configuration.Get[bool](configuration.MutantTypeEnabledKey(mt)) {
-			return
-		}
 		mutantType := mt
 		tm := NewTokenMutant(pkg, set, file, node)
 		tm.SetType(mutantType)
 		tm.SetStatus(mu.mutationStatus(set.Position(node.TokPos)))
 
-		mu.mutantStream <- tm
+		mutants = append(mutants, tm)
 	}
+
+	return mutants
+}
+
+// enabledTokenTypes returns the enabled mutator.Type that apply to the
+// token.Token, in the order of TokenMutantType. The types following a
+// disabled one are not applied.
+func enabledTokenTypes(tok token.Token) []mutator.Type {
+	var types []mutator.Type
+	for _, mt := range TokenMutantType[tok] {
+		if !configuration.Get[bool](configuration.MutantTypeEnabledKey(mt)) {
+			break
+		}
+		types = append(types, mt)
+	}
+
+	return types
+}
+
+func (mu *Engine) findNodeMutations(fileName string, set *token.FileSet, file *ast.File, node ast.Node, c *cursor) []mutator.Mutator {
+	var mutants []mutator.Mutator
+	for _, mt := range mutator.Types {
+		find, ok := nodeMutantType[mt]
+		if !ok || !configuration.Get[bool](configuration.MutantTypeEnabledKey(mt)) {
+			continue
+		}
+		mutants = append(mutants, mu.newNodeMutants(fileName, set, file, mt, find(node, c), nil)...)
+	}
+	for _, r := range mu.rules {
+		mutants = append(mutants, mu.newNodeMutants(fileName, set, file, r.mt, r.find(node, c), nil)...)
+	}
+	for _, o := range registeredOperators() {
+		if !configuration.Get[bool](configuration.MutantTypeEnabledKey(o.mt)) {
+			continue
+		}
+		mutants = append(mutants, mu.newNodeMutants(fileName, set, file, o.mt, o.Find(node, c), o.newMutant)...)
+	}
+
+	return mutants
+}
+
+// needsTypes tells if any of the enabled node mutators relies on the
+// types.Info, so that the packages must be type checked. The rules are
+// matched on the syntax alone, while the custom operators cannot tell and
+// are assumed to rely on it.
+func needsTypes() bool {
+	for mt := range nodeMutantType {
+		if !untypedNodeMutantTypes[mt] && configuration.Get[bool](configuration.MutantTypeEnabledKey(mt)) {
+			return true
+		}
+	}
+	for _, o := range registeredOperators() {
+		if configuration.Get[bool](configuration.MutantTypeEnabledKey(o.mt)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newNodeMutants creates the mutants of the mutations, with the given
+// constructor or, if nil, as NodeMutator.
+func (mu *Engine) newNodeMutants(fileName string, set *token.FileSet, file *ast.File, mt mutator.Type, mutations []NodeMutation,
+	newMutant func(string, *token.FileSet, *ast.File, NodeMutation) mutator.Mutator) []mutator.Mutator {
+	if len(mutations) == 0 {
+		return nil
+	}
+	mutants := make([]mutator.Mutator, 0, len(mutations))
+	pkg := mu.pkgName(fileName, file.Name.Name)
+	for _, m := range mutations {
+		var nm mutator.Mutator
+		if newMutant != nil {
+			nm = newMutant(pkg, set, file, m)
+		} else {
+			nm = NewNodeMutant(pkg, set, file, m)
+		}
+		nm.SetType(mt)
+		nm.SetStatus(mu.mutationStatus(set.Position(m.Pos)))
+
+		mutants = append(mutants, nm)
+	}
+
+	return mutants
 }
 
 func (mu *Engine) pkgName(fileName, fPkg string) string {
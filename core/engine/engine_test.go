@@ -59,6 +59,7 @@ type mutationsTest struct {
 	mutantType mutator.Type
 	token      token.Token
 	mutStatus  mutator.Status
+	noMutants  bool
 }
 
 var mutationsTests = []mutationsTest{
@@ -472,6 +473,365 @@ var mutationsTests = []mutationsTest{
 		covResult:  notCoveredPosition("testdata/fixtures/shr_assign_go"),
 		mutStatus:  mutator.NotCovered,
 	},
+	// STATEMENT_REMOVAL
+	{
+		name:       "it recognizes STATEMENT_REMOVAL with ExprStmt",
+		fixture:    "testdata/fixtures/expr_stmt_go",
+		mutantType: mutator.StatementRemoval,
+		covResult:  notCoveredPosition("testdata/fixtures/expr_stmt_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	{
+		name:       "it recognizes STATEMENT_REMOVAL with AssignStmt",
+		fixture:    "testdata/fixtures/assign_go",
+		mutantType: mutator.StatementRemoval,
+		covResult:  notCoveredPosition("testdata/fixtures/assign_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// RETURN_VALUES
+	{
+		name:       "it recognizes RETURN_VALUES with ReturnStmt",
+		fixture:    "testdata/fixtures/return_go",
+		mutantType: mutator.ReturnValues,
+		covResult:  notCoveredPosition("testdata/fixtures/return_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// ERROR_RETURN_NIL
+	{
+		name:       "it recognizes ERROR_RETURN_NIL with ReturnStmt",
+		fixture:    "testdata/fixtures/error_return_go",
+		mutantType: mutator.ErrorReturnNil,
+		covResult:  notCoveredPosition("testdata/fixtures/error_return_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// ERROR_GUARD_REMOVAL
+	{
+		name:       "it recognizes ERROR_GUARD_REMOVAL with IfStmt",
+		fixture:    "testdata/fixtures/error_guard_go",
+		mutantType: mutator.ErrorGuardRemoval,
+		covResult:  notCoveredPosition("testdata/fixtures/error_guard_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// ERROR_CHECK_NEGATION
+	{
+		name:       "it recognizes ERROR_CHECK_NEGATION with errors.Is",
+		fixture:    "testdata/fixtures/errors_is_go",
+		mutantType: mutator.ErrorCheckNegation,
+		covResult:  notCoveredPosition("testdata/fixtures/errors_is_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// ERROR_UNWRAP
+	{
+		name:       "it recognizes ERROR_UNWRAP with fmt.Errorf",
+		fixture:    "testdata/fixtures/errorf_go",
+		mutantType: mutator.ErrorUnwrap,
+		covResult:  notCoveredPosition("testdata/fixtures/errorf_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// NUMERIC_LITERAL
+	{
+		name:       "it recognizes NUMERIC_LITERAL with integer literals",
+		fixture:    "testdata/fixtures/numeric_literal_go",
+		mutantType: mutator.NumericLiteral,
+		covResult:  notCoveredPosition("testdata/fixtures/numeric_literal_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// STRING_LITERAL
+	{
+		name:       "it recognizes STRING_LITERAL with string literals",
+		fixture:    "testdata/fixtures/string_literal_go",
+		mutantType: mutator.StringLiteral,
+		covResult:  notCoveredPosition("testdata/fixtures/string_literal_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// INVERT_BOOLEANS
+	{
+		name:       "it recognizes INVERT_BOOLEANS with true and false",
+		fixture:    "testdata/fixtures/bool_go",
+		mutantType: mutator.InvertBooleans,
+		covResult:  notCoveredPosition("testdata/fixtures/bool_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// FORCE_CONDITIONS
+	{
+		name:       "it recognizes FORCE_CONDITIONS with if statements",
+		fixture:    "testdata/fixtures/if_cond_go",
+		mutantType: mutator.ForceConditions,
+		covResult:  notCoveredPosition("testdata/fixtures/if_cond_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// REMOVE_NOT
+	{
+		name:       "it recognizes REMOVE_NOT with NOT",
+		fixture:    "testdata/fixtures/not_go",
+		mutantType: mutator.RemoveNot,
+		covResult:  notCoveredPosition("testdata/fixtures/not_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// REMOVE_CASE
+	{
+		name:       "it recognizes REMOVE_CASE with switch statements",
+		fixture:    "testdata/fixtures/switch_go",
+		mutantType: mutator.RemoveCase,
+		covResult:  notCoveredPosition("testdata/fixtures/switch_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// EMPTY_CASE
+	{
+		name:       "it recognizes EMPTY_CASE with switch statements",
+		fixture:    "testdata/fixtures/switch_go",
+		mutantType: mutator.EmptyCase,
+		covResult:  notCoveredPosition("testdata/fixtures/switch_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// REMOVE_DEFAULT
+	{
+		name:       "it recognizes REMOVE_DEFAULT with switch statements",
+		fixture:    "testdata/fixtures/switch_default_go",
+		mutantType: mutator.RemoveDefault,
+		covResult:  notCoveredPosition("testdata/fixtures/switch_default_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// SLICE_BOUNDARY
+	{
+		name:       "it recognizes SLICE_BOUNDARY with slice expressions",
+		fixture:    "testdata/fixtures/slice_go",
+		mutantType: mutator.SliceBoundary,
+		covResult:  notCoveredPosition("testdata/fixtures/slice_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// SLICE_BOUND_REMOVAL
+	{
+		name:       "it recognizes SLICE_BOUND_REMOVAL with slice expressions",
+		fixture:    "testdata/fixtures/slice_go",
+		mutantType: mutator.SliceBoundRemoval,
+		covResult:  notCoveredPosition("testdata/fixtures/slice_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// INDEX_SHIFT
+	{
+		name:       "it recognizes INDEX_SHIFT with index expressions",
+		fixture:    "testdata/fixtures/index_go",
+		mutantType: mutator.IndexShift,
+		covResult:  notCoveredPosition("testdata/fixtures/index_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// INLINE_GOROUTINE
+	{
+		name:       "it recognizes INLINE_GOROUTINE with go statements",
+		fixture:    "testdata/fixtures/go_stmt_go",
+		mutantType: mutator.InlineGoroutine,
+		covResult:  notCoveredPosition("testdata/fixtures/go_stmt_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// REMOVE_DEFER
+	{
+		name:       "it recognizes REMOVE_DEFER with defer statements",
+		fixture:    "testdata/fixtures/defer_go",
+		mutantType: mutator.RemoveDefer,
+		covResult:  notCoveredPosition("testdata/fixtures/defer_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// REMOVE_LOCK
+	{
+		name:       "it recognizes REMOVE_LOCK with Lock and Unlock pairs",
+		fixture:    "testdata/fixtures/mutex_go",
+		mutantType: mutator.RemoveLock,
+		covResult:  notCoveredPosition("testdata/fixtures/mutex_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// RLOCK_TO_LOCK
+	{
+		name:       "it recognizes RLOCK_TO_LOCK with RLock and RUnlock pairs",
+		fixture:    "testdata/fixtures/rwmutex_go",
+		mutantType: mutator.RLockToLock,
+		covResult:  notCoveredPosition("testdata/fixtures/rwmutex_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// WAITGROUP_ADD
+	{
+		name:       "it recognizes WAITGROUP_ADD with sync.WaitGroup.Add",
+		fixture:    "testdata/fixtures/waitgroup_go",
+		mutantType: mutator.WaitGroupAdd,
+		covResult:  notCoveredPosition("testdata/fixtures/waitgroup_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// ONCE_DO
+	{
+		name:       "it recognizes ONCE_DO with sync.Once.Do",
+		fixture:    "testdata/fixtures/once_go",
+		mutantType: mutator.OnceDo,
+		covResult:  notCoveredPosition("testdata/fixtures/once_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// CHANNEL_BUFFER
+	{
+		name:       "it recognizes CHANNEL_BUFFER with buffered channels",
+		fixture:    "testdata/fixtures/make_chan_go",
+		mutantType: mutator.ChannelBuffer,
+		covResult:  notCoveredPosition("testdata/fixtures/make_chan_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// REMOVE_CLOSE
+	{
+		name:       "it recognizes REMOVE_CLOSE with close",
+		fixture:    "testdata/fixtures/defer_go",
+		mutantType: mutator.RemoveClose,
+		covResult:  notCoveredPosition("testdata/fixtures/defer_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// SELECT_DEFAULT
+	{
+		name:       "it recognizes SELECT_DEFAULT with select statements",
+		fixture:    "testdata/fixtures/select_go",
+		mutantType: mutator.SelectDefault,
+		covResult:  notCoveredPosition("testdata/fixtures/select_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// CONTEXT_BACKGROUND
+	{
+		name:       "it recognizes CONTEXT_BACKGROUND with context arguments",
+		fixture:    "testdata/fixtures/context_go",
+		mutantType: mutator.ContextBackground,
+		covResult:  notCoveredPosition("testdata/fixtures/context_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// CONTEXT_WRAP_REMOVAL
+	{
+		name:       "it recognizes CONTEXT_WRAP_REMOVAL with context.WithCancel",
+		fixture:    "testdata/fixtures/context_go",
+		mutantType: mutator.ContextWrapRemoval,
+		covResult:  notCoveredPosition("testdata/fixtures/context_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// ARGUMENT_SWAP
+	{
+		name:       "it recognizes ARGUMENT_SWAP with same-typed arguments",
+		fixture:    "testdata/fixtures/arguments_go",
+		mutantType: mutator.ArgumentSwap,
+		covResult:  notCoveredPosition("testdata/fixtures/arguments_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// REMOVE_APPEND
+	{
+		name:       "it recognizes REMOVE_APPEND with append",
+		fixture:    "testdata/fixtures/collections_go",
+		mutantType: mutator.RemoveAppend,
+		covResult:  notCoveredPosition("testdata/fixtures/collections_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// REMOVE_DELETE
+	{
+		name:       "it recognizes REMOVE_DELETE with delete",
+		fixture:    "testdata/fixtures/collections_go",
+		mutantType: mutator.RemoveDelete,
+		covResult:  notCoveredPosition("testdata/fixtures/collections_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// REMOVE_MAP_WRITE
+	{
+		name:       "it recognizes REMOVE_MAP_WRITE with map assignments",
+		fixture:    "testdata/fixtures/collections_go",
+		mutantType: mutator.RemoveMapWrite,
+		covResult:  notCoveredPosition("testdata/fixtures/collections_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// FIELD_REMOVAL
+	{
+		name:       "it recognizes FIELD_REMOVAL with keyed composite literals",
+		fixture:    "testdata/fixtures/composite_go",
+		mutantType: mutator.FieldRemoval,
+		covResult:  notCoveredPosition("testdata/fixtures/composite_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// API_SWAP
+	{
+		name:       "it recognizes API_SWAP with the functions of the table",
+		fixture:    "testdata/fixtures/apiswap_go",
+		mutantType: mutator.APISwap,
+		covResult:  notCoveredPosition("testdata/fixtures/apiswap_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// RELATIONAL_REPLACEMENT
+	{
+		name:       "it recognizes RELATIONAL_REPLACEMENT with LSS",
+		fixture:    "testdata/fixtures/lss_go",
+		mutantType: mutator.RelationalReplacement,
+		covResult:  notCoveredPosition("testdata/fixtures/lss_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// ARITHMETIC_REPLACEMENT
+	{
+		name:       "it recognizes ARITHMETIC_REPLACEMENT with ADD",
+		fixture:    "testdata/fixtures/add_go",
+		mutantType: mutator.ArithmeticReplacement,
+		covResult:  notCoveredPosition("testdata/fixtures/add_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// LOGICAL_REPLACEMENT
+	{
+		name:       "it recognizes LOGICAL_REPLACEMENT with LAND",
+		fixture:    "testdata/fixtures/land_go",
+		mutantType: mutator.LogicalReplacement,
+		covResult:  notCoveredPosition("testdata/fixtures/land_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// BUILTIN_LENGTH
+	{
+		name:       "it recognizes BUILTIN_LENGTH with len",
+		fixture:    "testdata/fixtures/builtin_go",
+		mutantType: mutator.BuiltinLength,
+		covResult:  notCoveredPosition("testdata/fixtures/builtin_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// BUILTIN_MIN_MAX
+	{
+		name:       "it recognizes BUILTIN_MIN_MAX with min",
+		fixture:    "testdata/fixtures/builtin_go",
+		mutantType: mutator.BuiltinMinMax,
+		covResult:  notCoveredPosition("testdata/fixtures/builtin_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// BUILTIN_COPY
+	{
+		name:       "it recognizes BUILTIN_COPY with copy",
+		fixture:    "testdata/fixtures/builtin_go",
+		mutantType: mutator.BuiltinCopy,
+		covResult:  notCoveredPosition("testdata/fixtures/builtin_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// REMOVE_RECOVER
+	{
+		name:       "it recognizes REMOVE_RECOVER with recover",
+		fixture:    "testdata/fixtures/panic_go",
+		mutantType: mutator.RemoveRecover,
+		covResult:  notCoveredPosition("testdata/fixtures/panic_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// REMOVE_PANIC
+	{
+		name:       "it recognizes REMOVE_PANIC with panic",
+		fixture:    "testdata/fixtures/panic_go",
+		mutantType: mutator.RemovePanic,
+		covResult:  notCoveredPosition("testdata/fixtures/panic_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// LOOP_SKIP
+	{
+		name:       "it recognizes LOOP_SKIP with ForStmt",
+		fixture:    "testdata/fixtures/loop_go",
+		mutantType: mutator.LoopSkip,
+		covResult:  notCoveredPosition("testdata/fixtures/loop_go"),
+		mutStatus:  mutator.NotCovered,
+	},
+	// LOOP_ONCE
+	{
+		name:       "it recognizes LOOP_ONCE with ForStmt",
+		fixture:    "testdata/fixtures/loop_go",
+		mutantType: mutator.LoopOnce,
+		covResult:  notCoveredPosition("testdata/fixtures/loop_go"),
+		mutStatus:  mutator.NotCovered,
+	},
 	// Common behaviours
 	{
 		name:       "it works with recursion",
@@ -487,6 +847,7 @@ var mutationsTests = []mutationsTest{
 		mutantType: mutator.ConditionalsBoundary,
 		token:      token.ILLEGAL,
 		covResult:  notCoveredPosition("testdata/fixtures/illegal_go"),
+		noMutants:  true,
 	},
 }
 
@@ -510,9 +871,11 @@ func TestMutations(t *testing.T) {
 				t.Errorf("expected module to be %q, got %q", expectedModule, res.Module)
 			}
 
-			if tc.token == token.ILLEGAL {
-				if len(got) != 0 {
-					t.Errorf("expected no mutator found")
+			if tc.noMutants {
+				for _, g := range got {
+					if g.Type() == tc.mutantType {
+						t.Errorf("expected no %s mutator found", tc.mutantType)
+					}
 				}
 
 				return
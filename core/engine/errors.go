@@ -0,0 +1,207 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/go-maxhub/gremlins/core/configuration"
+	"github.com/go-maxhub/gremlins/core/mutator"
+)
+
+// findErrorReturnNils turns each error returned by a return statement into
+// nil, one at a time.
+//
+// When RETURN_VALUES is enabled, the mutations leaving only zero values,
+// such as `return err` becoming `return nil`, are left to it.
+func findErrorReturnNils(n ast.Node, c *cursor) []NodeMutation {
+	ret, ok := n.(*ast.ReturnStmt)
+	if !ok || len(ret.Results) == 0 {
+		return nil
+	}
+	fType := c.enclosingFunc()
+	if fType == nil || fType.Results == nil || fType.Results.NumFields() != len(ret.Results) {
+		return nil
+	}
+
+	var zeros []ast.Expr
+	if configuration.Get[bool](configuration.MutantTypeEnabledKey(mutator.ReturnValues)) {
+		zeros, _ = zeroResults(c.info, fType)
+	}
+
+	var mutations []NodeMutation
+	i := 0
+	for _, field := range fType.Results.List {
+		for j := 0; j < max(len(field.Names), 1); j++ {
+			res, left := ret.Results[i], onlyZeroesLeft(ret.Results, i, zeros)
+			i++
+			if !isError(typeOf(c.info, field.Type)) || isNil(res) || left {
+				continue
+			}
+			mutations = append(mutations, NodeMutation{
+				Pos: res.Pos(),
+				Mutate: func() func() {
+					return replaceNode(ret, res, ast.NewIdent("nil"))
+				},
+			})
+		}
+	}
+
+	return mutations
+}
+
+// onlyZeroesLeft tells if the results are the zero values once the one at
+// index i is set to nil.
+func onlyZeroesLeft(results []ast.Expr, i int, zeros []ast.Expr) bool {
+	if zeros == nil {
+		return false
+	}
+	mutated := append([]ast.Expr{}, results...)
+	mutated[i] = ast.NewIdent("nil")
+
+	return sameExprs(zeros, mutated)
+}
+
+// findErrorGuardRemovals drops the body of the if statements guarding
+// against non-nil errors, such as `if err != nil { ... }`.
+func findErrorGuardRemovals(n ast.Node, c *cursor) []NodeMutation {
+	ifStmt, ok := n.(*ast.IfStmt)
+	if !ok || len(ifStmt.Body.List) == 0 {
+		return nil
+	}
+	cond, ok := unparen(ifStmt.Cond).(*ast.BinaryExpr)
+	if !ok || cond.Op != token.NEQ {
+		return nil
+	}
+	errExpr := cond.X
+	if isNil(errExpr) {
+		errExpr = cond.Y
+	} else if !isNil(cond.Y) {
+		return nil
+	}
+	if !isError(typeOf(c.info, errExpr)) {
+		return nil
+	}
+
+	return []NodeMutation{{
+		Pos: ifStmt.Pos(),
+		Mutate: func() func() {
+			orig := ifStmt.Body.List
+			ifStmt.Body.List = nil
+
+			return func() {
+				ifStmt.Body.List = orig
+			}
+		},
+	}}
+}
+
+// findErrorCheckNegations negates the checks made with errors.Is and
+// errors.As. The calls whose result is discarded are skipped, as well as
+// the ones of go and defer statements, which can only hold a call.
+func findErrorCheckNegations(n ast.Node, c *cursor) []NodeMutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || !isPkgFunc(c.info, call, "errors", "Is", "As") {
+		return nil
+	}
+	parent := c.parent()
+	if _, ok := parent.(*ast.ExprStmt); ok {
+		return nil
+	}
+	not := &ast.UnaryExpr{Op: token.NOT, X: call}
+	if !canReplace(parent, call, not) {
+		return nil
+	}
+
+	return []NodeMutation{{
+		Pos: call.Pos(),
+		Mutate: func() func() {
+			return replaceNode(parent, call, not)
+		},
+	}}
+}
+
+// findErrorUnwraps downgrades the %w verbs of fmt.Errorf to %v, so that
+// the returned error doesn't wrap its cause anymore.
+func findErrorUnwraps(n ast.Node, c *cursor) []NodeMutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 || !isPkgFunc(c.info, call, "fmt", "Errorf") {
+		return nil
+	}
+	format, ok := call.Args[0].(*ast.BasicLit)
+	if !ok || format.Kind != token.STRING {
+		return nil
+	}
+	value, ok := unwrapVerbs(format.Value)
+	if !ok {
+		return nil
+	}
+
+	return []NodeMutation{{
+		Pos: format.Pos(),
+		Mutate: func() func() {
+			orig := format.Value
+			format.Value = value
+
+			return func() {
+				format.Value = orig
+			}
+		},
+	}}
+}
+
+// unwrapVerbs replaces the %w verbs of the quoted format string with %v.
+// It returns false if the format contains no %w verb.
+func unwrapVerbs(quoted string) (string, bool) {
+	format, err := strconv.Unquote(quoted)
+	if err != nil {
+		return "", false
+	}
+	var b strings.Builder
+	found := false
+	for i := 0; i < len(format); i++ {
+		b.WriteByte(format[i])
+		if format[i] != '%' || i+1 == len(format) {
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'w':
+			b.WriteByte('v')
+			found = true
+		default:
+			b.WriteByte(format[i])
+		}
+	}
+	if !found {
+		return "", false
+	}
+	if quoted[0] == '`' && !strings.Contains(b.String(), "`") {
+		return "`" + b.String() + "`", true
+	}
+
+	return strconv.Quote(b.String()), true
+}
+
+func isNil(e ast.Expr) bool {
+	id, ok := unparen(e).(*ast.Ident)
+
+	return ok && id.Name == "nil"
+}
@@ -17,6 +17,7 @@
 package engine
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -26,6 +27,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/go-maxhub/gremlins/core/coverage"
 	"github.com/go-maxhub/gremlins/core/engine/workdir"
 	"github.com/go-maxhub/gremlins/core/engine/workerpool"
 	"github.com/go-maxhub/gremlins/core/log"
@@ -61,6 +63,8 @@ type MutantExecutorDealer struct {
 	testExecutionTime time.Duration
 	dryRun            bool
 	integrationMode   bool
+	race              bool
+	baselineRaces     map[string]bool
 	testCPU           int
 }
 
@@ -76,11 +80,26 @@ func WithExecContext(c execContext) ExecutorDealerOption {
 	}
 }
 
+// WithBaselineRaces sets the packages whose tests have data races even
+// without any mutation. Their tests are run without the race detector, so
+// that the existing races don't kill the mutants.
+func WithBaselineRaces(pkgs []string) ExecutorDealerOption {
+	return func(m MutantExecutorDealer) MutantExecutorDealer {
+		m.baselineRaces = make(map[string]bool, len(pkgs))
+		for _, pkg := range pkgs {
+			m.baselineRaces[pkg] = true
+		}
+
+		return m
+	}
+}
+
 // NewExecutorDealer initialises a MutantExecutorDealer.
 func NewExecutorDealer(mod gomodule.GoModule, wdd workdir.Dealer, elapsed time.Duration, opts ...ExecutorDealerOption) *MutantExecutorDealer {
 	buildTags := configuration.Get[string](configuration.UnleashTagsKey)
 	dryRun := configuration.Get[bool](configuration.UnleashDryRunKey)
 	integrationMode := configuration.Get[bool](configuration.UnleashIntegrationMode)
+	race := configuration.Get[bool](configuration.UnleashRaceKey)
 	testCPU := configuration.Get[int](configuration.UnleashTestCPUKey)
 	tCoefficient := configuration.Get[int](configuration.UnleashTimeoutCoefficientKey)
 
@@ -99,6 +118,7 @@ func NewExecutorDealer(mod gomodule.GoModule, wdd workdir.Dealer, elapsed time.D
 		buildTags:         buildTags,
 		dryRun:            dryRun,
 		integrationMode:   integrationMode,
+		race:              race,
 		testCPU:           testCPU,
 		testExecutionTime: elapsed * time.Duration(coefficient),
 		execContext:       exec.CommandContext,
@@ -124,6 +144,7 @@ func (m MutantExecutorDealer) NewExecutor(mut mutator.Mutator, outCh chan<- muta
 		module:            m.mod,
 		dryRun:            m.dryRun,
 		integrationMode:   m.integrationMode,
+		race:              m.raceEnabled(mut.Pkg()),
 		buildTags:         m.buildTags,
 		execContext:       m.execContext,
 		testCPU:           m.testCPU,
@@ -133,6 +154,17 @@ func (m MutantExecutorDealer) NewExecutor(mut mutator.Mutator, outCh chan<- muta
 	return &mj
 }
 
+// raceEnabled tells if the race detector is used for the tests of the
+// package. In integration mode, all the tests are run, so any package with
+// data races disables it.
+func (m MutantExecutorDealer) raceEnabled(pkg string) bool {
+	if m.integrationMode {
+		return m.race && len(m.baselineRaces) == 0
+	}
+
+	return m.race && !m.baselineRaces[pkg]
+}
+
 type execContext = func(ctx context.Context, name string, args ...string) *exec.Cmd
 
 type mutantExecutor struct {
@@ -146,6 +178,7 @@ type mutantExecutor struct {
 	testExecutionTime time.Duration
 	dryRun            bool
 	integrationMode   bool
+	race              bool
 	testCPU           int
 }
 
@@ -159,6 +192,9 @@ type mutantExecutor struct {
 // The timeout of the test is managed outside the run of the test, using
 // a context with timeout. This is done because the Go test command doesn't
 // make it easy to distinguish failures from timeouts.
+// If the race detector is enabled and it makes the tests fail, the race is
+// recorded in the mutant and reported along with the KILLED mutant.
+// If the mutator cannot be applied, it is reported as NOT VIABLE.
 func (m *mutantExecutor) Start(w *workerpool.Worker) {
 	defer m.wg.Done()
 	workerName := fmt.Sprintf("%s-%d", w.Name, w.ID)
@@ -179,11 +215,18 @@ func (m *mutantExecutor) Start(w *workerpool.Worker) {
 
 	if err := m.mutant.Apply(); err != nil {
 		log.Errorf("failed to apply mutation at %s - %s\n\t%v", m.mutant.Position(), m.mutant.Status(), err)
+		m.mutant.SetStatus(mutator.NotViable)
+		m.outCh <- m.mutant
+		report.Mutant(m.mutant)
 
 		return
 	}
 
-	m.mutant.SetStatus(m.runTests(rootDir, m.mutant.Pkg()))
+	status, race := m.runTests(rootDir, m.mutant.Pkg())
+	m.mutant.SetStatus(status)
+	if r, ok := m.mutant.(mutator.RaceRecorder); ok {
+		r.SetRace(race)
+	}
 
 	if err := m.mutant.Rollback(); err != nil {
 		// What should we do now?
@@ -192,9 +235,12 @@ func (m *mutantExecutor) Start(w *workerpool.Worker) {
 
 	m.outCh <- m.mutant
 	report.Mutant(m.mutant)
+	if race {
+		report.Race(m.mutant)
+	}
 }
 
-func (m *mutantExecutor) runTests(rootDir, pkg string) mutator.Status {
+func (m *mutantExecutor) runTests(rootDir, pkg string) (mutator.Status, bool) {
 	ctx, cancel := context.WithTimeout(context.Background(), m.testExecutionTime)
 	defer cancel()
 
@@ -205,19 +251,27 @@ func (m *mutantExecutor) runTests(rootDir, pkg string) mutator.Status {
 	}
 	cmd.Env = append(cmd.Env, os.Environ()...)
 	cmd.Env = append(cmd.Env, fmt.Sprintf("GOTMPDIR=%s", m.wdDealer.WorkDir()))
+	var out bytes.Buffer
+	if m.race {
+		cmd.Stdout = &out
+		cmd.Stderr = &out
+	}
 
 	rel, err := run(cmd)
 	defer rel()
 
 	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
-		return mutator.TimedOut
+		return mutator.TimedOut, false
 	}
 	var exitErr *exec.ExitError
 	if errors.As(err, &exitErr) {
-		return getTestFailedStatus(exitErr.ExitCode())
+		status := getTestFailedStatus(exitErr.ExitCode())
+		race := m.race && status == mutator.Killed && bytes.Contains(out.Bytes(), []byte(coverage.RaceWarning))
+
+		return status, race
 	}
 
-	return mutator.Lived
+	return mutator.Lived, false
 }
 
 func (m *mutantExecutor) getTestArgs(pkg string) []string {
@@ -231,6 +285,10 @@ func (m *mutantExecutor) getTestArgs(pkg string) []string {
 	args = append(args, "-timeout", (2*time.Second + m.testExecutionTime).String())
 	args = append(args, "-failfast")
 
+	if m.race {
+		args = append(args, "-race")
+	}
+
 	if m.testCPU != 0 {
 		args = append(args, fmt.Sprintf("-cpu %d", m.testCPU))
 	}
@@ -17,8 +17,10 @@
 package engine_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"go/token"
 	"os"
 	"os/exec"
 	"strings"
@@ -32,6 +34,7 @@ import (
 	"github.com/go-maxhub/gremlins/core/engine"
 	"github.com/go-maxhub/gremlins/core/engine/workerpool"
 	"github.com/go-maxhub/gremlins/core/gomodule"
+	"github.com/go-maxhub/gremlins/core/log"
 	"github.com/go-maxhub/gremlins/core/mutator"
 )
 
@@ -76,7 +79,7 @@ func TestApplyAndRollback(t *testing.T) {
 		}
 	})
 
-	t.Run("does nothing if apply goes to error", func(t *testing.T) {
+	t.Run("reports NOT VIABLE if apply goes to error", func(t *testing.T) {
 		wdDealer := newWdDealerStub(t)
 		tmpDir, _ := wdDealer.Get("")
 		mod := gomodule.GoModule{
@@ -99,14 +102,22 @@ func TestApplyAndRollback(t *testing.T) {
 			Name: "test",
 			ID:   1,
 		}
+		var got mutator.Mutator
+		done := make(chan struct{})
 		go func() {
-			<-outCh
+			got = <-outCh
 			close(outCh)
+			close(done)
 		}()
 
 		executor.Start(w)
 
 		wg.Wait()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("expected the mutant to be reported")
+		}
 
 		if !mut.applyCalled {
 			t.Errorf("expected apply to be called")
@@ -115,6 +126,10 @@ func TestApplyAndRollback(t *testing.T) {
 		if mut.rollbackCalled {
 			t.Errorf("expected rollback not to be called")
 		}
+
+		if got != mut || got.Status() != mutator.NotViable {
+			t.Errorf("expected the mutant to be reported as %s", mutator.NotViable)
+		}
 	})
 }
 
@@ -217,6 +232,78 @@ func TestMutatorTestExecution(t *testing.T) {
 	}
 }
 
+func TestMutatorRaceDetection(t *testing.T) {
+	testCases := []struct {
+		testResult execContext
+		name       string
+		want       string
+		race       bool
+		wantRace   bool
+	}{
+		{
+			name:       "it reports the data races found by the race detector",
+			testResult: fakeExecCommandRace,
+			race:       true,
+			wantRace:   true,
+			want: "      KILLED CONDITIONALS_BOUNDARY at aFolder/aFile.go:12:3\n" +
+				"   DATA RACE CONDITIONALS_BOUNDARY at aFolder/aFile.go:12:3\n",
+		},
+		{
+			name:       "it doesn't report data races of failures",
+			testResult: fakeExecCommandTestsFailure,
+			race:       true,
+			want:       "      KILLED CONDITIONALS_BOUNDARY at aFolder/aFile.go:12:3\n",
+		},
+		{
+			name:       "it doesn't report data races when the race detector is disabled",
+			testResult: fakeExecCommandRace,
+			want:       "      KILLED CONDITIONALS_BOUNDARY at aFolder/aFile.go:12:3\n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			viperSet(map[string]any{configuration.UnleashRaceKey: tc.race})
+			defer viperReset()
+			out := &bytes.Buffer{}
+			log.Init(out, &bytes.Buffer{})
+			defer log.Reset()
+
+			mod := gomodule.GoModule{
+				Name:       "example.com",
+				Root:       ".",
+				CallingDir: ".",
+			}
+			mjd := engine.NewExecutorDealer(mod, newWdDealerStub(t), expectedTimeout,
+				engine.WithExecContext(tc.testResult),
+			)
+			mut := &mutantStub{
+				status:   mutator.Runnable,
+				mutType:  mutator.ConditionalsBoundary,
+				pkg:      "example.com",
+				position: token.Position{Filename: "aFolder/aFile.go", Line: 12, Column: 3},
+			}
+			outCh := make(chan mutator.Mutator)
+			wg := sync.WaitGroup{}
+			wg.Add(1)
+			executor := mjd.NewExecutor(mut, outCh, &wg)
+			go func() {
+				<-outCh
+				close(outCh)
+			}()
+			executor.Start(&workerpool.Worker{Name: "test", ID: 1})
+			wg.Wait()
+
+			if got := out.String(); got != tc.want {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+			if mut.race != tc.wantRace {
+				t.Errorf("expected the race of the mutant to be %v, got %v", tc.wantRace, mut.race)
+			}
+		})
+	}
+}
+
 const expectedTimeout = 10 * time.Second
 
 type commandHolder struct {
@@ -234,8 +321,11 @@ func TestMutatorRun(t *testing.T) {
 		callDir            string
 		tags               string
 		wantPath           string
+		baselineRaces      []string
 		timeoutCoefficient int
 		intMode            bool
+		race               bool
+		wantRace           bool
 	}{
 		{
 			name:     "normal mode",
@@ -261,6 +351,44 @@ func TestMutatorRun(t *testing.T) {
 			tags:               "tag1,t1g2",
 			wantPath:           "example.com/my/package",
 		},
+		{
+			name:     "race mode enables the race detector",
+			race:     true,
+			wantRace: true,
+			pkg:      "example.com/my/package",
+			callDir:  "test/dir",
+			tags:     "tag1,t1g2",
+			wantPath: "example.com/my/package",
+		},
+		{
+			name:          "race mode doesn't enable the race detector on packages with races",
+			race:          true,
+			baselineRaces: []string{"example.com/my/package"},
+			pkg:           "example.com/my/package",
+			callDir:       "test/dir",
+			tags:          "tag1,t1g2",
+			wantPath:      "example.com/my/package",
+		},
+		{
+			name:          "race mode enables the race detector on packages without races",
+			race:          true,
+			wantRace:      true,
+			baselineRaces: []string{"example.com/my/other"},
+			pkg:           "example.com/my/package",
+			callDir:       "test/dir",
+			tags:          "tag1,t1g2",
+			wantPath:      "example.com/my/package",
+		},
+		{
+			name:          "race mode doesn't enable the race detector in integration mode with races",
+			race:          true,
+			intMode:       true,
+			baselineRaces: []string{"example.com/my/other"},
+			pkg:           "example.com/my/package",
+			callDir:       "test/dir",
+			tags:          "tag1,t1g2",
+			wantPath:      "./...",
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -268,6 +396,7 @@ func TestMutatorRun(t *testing.T) {
 			settings := map[string]any{
 				configuration.UnleashIntegrationMode: tc.intMode,
 				configuration.UnleashTagsKey:         tc.tags,
+				configuration.UnleashRaceKey:         tc.race,
 			}
 			if tc.timeoutCoefficient != 0 {
 				settings[configuration.UnleashTimeoutCoefficientKey] = tc.timeoutCoefficient
@@ -283,7 +412,8 @@ func TestMutatorRun(t *testing.T) {
 			wdDealer := newWdDealerStub(t)
 			holder := &commandHolder{}
 			mjd := engine.NewExecutorDealer(mod, wdDealer, expectedTimeout,
-				engine.WithExecContext(fakeExecCommandSuccessWithHolder(holder)))
+				engine.WithExecContext(fakeExecCommandSuccessWithHolder(holder)),
+				engine.WithBaselineRaces(tc.baselineRaces))
 			mut := &mutantStub{
 				status:  mutator.Runnable,
 				mutType: mutator.ConditionalsBoundary,
@@ -304,18 +434,23 @@ func TestMutatorRun(t *testing.T) {
 			executor.Start(w)
 			wg.Wait()
 
-			wantTimeout := 2*time.Second + expectedTimeout*engine.DefaultTimeoutCoefficient
+			coefficient := time.Duration(engine.DefaultTimeoutCoefficient)
 			if tc.timeoutCoefficient != 0 {
-				wantTimeout = 2*time.Second + expectedTimeout*time.Duration(tc.timeoutCoefficient)
+				coefficient = time.Duration(tc.timeoutCoefficient)
 			}
-			want := fmt.Sprintf("go test -tags %s -timeout %s -failfast %s", tc.tags, wantTimeout, tc.wantPath)
+			raceFlag := ""
+			if tc.wantRace {
+				raceFlag = " -race"
+			}
+			wantTimeout := 2*time.Second + expectedTimeout*coefficient
+			want := fmt.Sprintf("go test -tags %s -timeout %s -failfast%s %s", tc.tags, wantTimeout, raceFlag, tc.wantPath)
 			got := fmt.Sprintf("go %v", strings.Join(holder.args, " "))
 
 			if !cmp.Equal(got, want) {
 				t.Errorf(fmt.Sprintf("\n+ %s\n- %s\n", got, want))
 			}
 
-			timeoutDifference := absTimeDiff(holder.timeout, expectedTimeout*2)
+			timeoutDifference := absTimeDiff(holder.timeout, expectedTimeout*coefficient)
 			diffThreshold := 100 * time.Second
 			if timeoutDifference > diffThreshold {
 				t.Errorf("expected timeout to be within %s from the set timeout, got %s", diffThreshold, timeoutDifference)
@@ -435,6 +570,15 @@ func TestProcessTestsFailure(_ *testing.T) {
 	os.Exit(1) // skipcq: RVV-A0003
 }
 
+func TestProcessRace(_ *testing.T) {
+	if os.Getenv("GO_TEST_PROCESS") != "1" {
+		return
+	}
+	fmt.Println("==================")
+	fmt.Println("WARNING: DATA RACE")
+	os.Exit(1) // skipcq: RVV-A0003
+}
+
 func TestProcessBuildFailure(_ *testing.T) {
 	if os.Getenv("GO_TEST_PROCESS") != "1" {
 		return
@@ -578,6 +722,13 @@ func fakeExecCommandTestsFailure(ctx context.Context, command string, args ...st
 	return getCmd(ctx, cs)
 }
 
+func fakeExecCommandRace(ctx context.Context, command string, args ...string) *exec.Cmd {
+	cs := []string{"-test.run=TestProcessRace", "--", command}
+	cs = append(cs, args...)
+
+	return getCmd(ctx, cs)
+}
+
 func fakeExecCommandBuildFailure(ctx context.Context, command string, args ...string) *exec.Cmd {
 	cs := []string{"-test.run=TestProcessBuildFailure", "--", command}
 	cs = append(cs, args...)
@@ -0,0 +1,66 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// findExtremeMutations returns the mutation of the extreme mode, making the
+// function return the zero values of its results straight away, or return
+// at all if it has none. A function whose tests still pass once mutated
+// is pseudo-tested: it is executed, but its behaviour is never checked.
+//
+// The return is inserted before the original statements instead of
+// replacing them, so that the imports and the variables they use are
+// still used and the mutant compiles. The functions with an empty body,
+// with results whose zero value cannot be expressed, or already returning
+// the zero values straight away, are skipped.
+func findExtremeMutations(n ast.Node, c *cursor) []NodeMutation {
+	fn, ok := n.(*ast.FuncDecl)
+	if !ok || fn.Body == nil || len(fn.Body.List) == 0 {
+		return nil
+	}
+	zeros, ok := zeroResults(c.info, fn.Type)
+	if !ok {
+		return nil
+	}
+	if r, ok := fn.Body.List[0].(*ast.ReturnStmt); ok && sameExprs(zeros, r.Results) {
+		return nil
+	}
+	ret := &ast.ReturnStmt{Return: fn.Body.Lbrace, Results: zeros}
+	body := fn.Body
+
+	return []NodeMutation{{
+		Pos:  body.List[0].Pos(),
+		Desc: funcName(fn),
+		Mutate: func() func() {
+			return insertStmt(body, 0, ret)
+		},
+	}}
+}
+
+// funcName returns the name of the declared function, qualified with its
+// receiver for the methods, such as `(*T).Name`.
+func funcName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+
+	return "(" + types.ExprString(fn.Recv.List[0].Type) + ")." + fn.Name.Name
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// findFieldRemovals removes, one at a time, the keyed elements of the
+// composite literals, such as the Retries field of
+// `Config{Timeout: t, Retries: 3}`. The mutation is described by the key
+// of the removed element.
+func findFieldRemovals(n ast.Node, _ *cursor) []NodeMutation {
+	lit, ok := n.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+
+	var mutations []NodeMutation
+	for i, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		i := i
+		mutations = append(mutations, NodeMutation{
+			Pos:  kv.Pos(),
+			Desc: types.ExprString(kv.Key),
+			Mutate: func() func() {
+				orig := lit.Elts
+				lit.Elts = make([]ast.Expr, 0, len(orig)-1)
+				lit.Elts = append(lit.Elts, orig[:i]...)
+				lit.Elts = append(lit.Elts, orig[i+1:]...)
+
+				return func() {
+					lit.Elts = orig
+				}
+			},
+		})
+	}
+
+	return mutations
+}
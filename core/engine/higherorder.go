@@ -0,0 +1,412 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-maxhub/gremlins/core/configuration"
+	"github.com/go-maxhub/gremlins/core/mutator"
+)
+
+// The strategies to select the mutants combined in a HigherOrderMutator.
+const (
+	// HOMRandom combines random mutants of the same package.
+	HOMRandom = "random"
+	// HOMSameFunction combines the mutants of the same function.
+	HOMSameFunction = "same-function"
+	// HOMAdjacent combines the mutants following each other in a file.
+	HOMAdjacent = "adjacent"
+)
+
+// HigherOrder configures the combination of the first-order mutants into
+// higher-order ones.
+type HigherOrder struct {
+	// Strategy is the strategy to select the combined mutants.
+	Strategy string
+	// Order is the number of mutants combined in each HigherOrderMutator.
+	// The higher-order mutants are disabled if it is lower than 2.
+	Order int
+}
+
+// NewHigherOrder reads the HigherOrder from the configuration.
+func NewHigherOrder() (HigherOrder, error) {
+	h := HigherOrder{
+		Order:    configuration.Get[int](configuration.UnleashHOMOrderKey),
+		Strategy: configuration.Get[string](configuration.UnleashHOMStrategyKey),
+	}
+	switch h.Strategy {
+	case "":
+		h.Strategy = HOMRandom
+	case HOMRandom, HOMSameFunction, HOMAdjacent:
+	default:
+		return HigherOrder{}, fmt.Errorf("unknown higher-order strategy %q", h.Strategy)
+	}
+
+	return h, nil
+}
+
+// WithHigherOrder enables the higher-order mutants.
+func WithHigherOrder(h HigherOrder) Option {
+	return func(m Engine) Engine {
+		m.hom = h
+
+		return m
+	}
+}
+
+func (h HigherOrder) enabled() bool {
+	return h.Order > 1
+}
+
+// firstOrder is implemented by the mutator.Mutator that can be combined in
+// a HigherOrderMutator: the rewrite of the AST is split from the write of
+// the file, so that more rewrites can be written at once.
+type firstOrder interface {
+	mutator.Mutator
+
+	// mutate rewrites the AST and returns the function that restores it.
+	mutate() (restore func())
+
+	// source returns the file the mutator.Mutator operates on.
+	source() (*token.FileSet, *ast.File)
+}
+
+// combine groups the runnable first-order mutants in HigherOrderMutator,
+// following the Strategy. The other mutants, and the ones left over, are
+// returned as they are.
+func (h HigherOrder) combine(mutants []mutator.Mutator, rnd *rand.Rand) []mutator.Mutator {
+	var result []mutator.Mutator
+	var keys []string
+	buckets := make(map[string][]firstOrder)
+	for _, m := range mutants {
+		fo, ok := m.(firstOrder)
+		key := h.bucket(fo)
+		if !ok || m.Status() != mutator.Runnable || key == "" {
+			result = append(result, m)
+
+			continue
+		}
+		if _, ok := buckets[key]; !ok {
+			keys = append(keys, key)
+		}
+		buckets[key] = append(buckets[key], fo)
+	}
+
+	for _, key := range keys {
+		bucket := buckets[key]
+		if h.Strategy == HOMRandom {
+			rnd.Shuffle(len(bucket), func(i, j int) {
+				bucket[i], bucket[j] = bucket[j], bucket[i]
+			})
+		} else {
+			sort.SliceStable(bucket, func(i, j int) bool {
+				return bucket[i].Pos() < bucket[j].Pos()
+			})
+		}
+		result = append(result, chunk(bucket, h.Order)...)
+	}
+
+	return result
+}
+
+// bucket returns the key of the group of mutants which can be combined
+// with the given one, or an empty string if it cannot be combined.
+func (h HigherOrder) bucket(fo firstOrder) string {
+	if fo == nil {
+		return ""
+	}
+	switch h.Strategy {
+	case HOMSameFunction:
+		_, file := fo.source()
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Pos() <= fo.Pos() && fo.Pos() < fn.End() {
+				return fmt.Sprintf("%s:%d", fo.Position().Filename, fn.Pos())
+			}
+		}
+
+		return ""
+	case HOMAdjacent:
+		return fo.Position().Filename
+	default:
+		return fo.Pkg()
+	}
+}
+
+// chunk splits the mutants in HigherOrderMutator of the given order. The
+// mutants at the same position of another one in the same group, or whose
+// rewrite cannot be applied together with the ones of the group, are left
+// alone.
+func chunk(mutants []firstOrder, order int) []mutator.Mutator {
+	var result []mutator.Mutator
+	var group []firstOrder
+	for _, m := range mutants {
+		if samePos(group, m) || clashes(group, m) {
+			result = append(result, m)
+
+			continue
+		}
+		group = append(group, m)
+		if len(group) == order {
+			result = append(result, newHigherOrderMutant(group))
+			group = nil
+		}
+	}
+	for _, m := range group {
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func samePos(group []firstOrder, m firstOrder) bool {
+	for _, g := range group {
+		if g.Position() == m.Position() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// clashes reports whether the rewrite of m fails once the ones of the group
+// are applied, such as when they already removed the node it replaces. The
+// rewrites are tried on the AST, which is restored right after.
+func clashes(group []firstOrder, m firstOrder) (clash bool) {
+	var restores []func()
+	defer func() {
+		for i := len(restores) - 1; i >= 0; i-- {
+			restores[i]()
+		}
+	}()
+	defer func() {
+		if r := recover(); r != nil {
+			clash = true
+		}
+	}()
+	for _, fo := range group {
+		restores = append(restores, fo.mutate())
+	}
+	restores = append(restores, m.mutate())
+
+	return false
+}
+
+// HigherOrderMutator is a mutator.Mutator combining several first-order
+// mutants, which are applied at once.
+//
+// It locks all the files it operates on, in lexical order, so that the
+// HigherOrderMutator spanning the same files never deadlock each other.
+type HigherOrderMutator struct {
+	origFiles map[string][]byte
+	workDir   string
+	mutants   []firstOrder
+	status    mutator.Status
+	race      bool
+}
+
+func newHigherOrderMutant(mutants []firstOrder) *HigherOrderMutator {
+	return &HigherOrderMutator{
+		mutants: mutants,
+		status:  mutator.Runnable,
+	}
+}
+
+// Mutants returns the first-order mutants combined by the
+// HigherOrderMutator.
+func (m *HigherOrderMutator) Mutants() []mutator.Mutator {
+	mutants := make([]mutator.Mutator, 0, len(m.mutants))
+	for _, fo := range m.mutants {
+		mutants = append(mutants, fo)
+	}
+
+	return mutants
+}
+
+// Type returns mutator.HigherOrder.
+func (m *HigherOrderMutator) Type() mutator.Type {
+	return mutator.HigherOrder
+}
+
+// SetType does nothing, since the mutator.Type of a HigherOrderMutator is
+// always mutator.HigherOrder.
+func (m *HigherOrderMutator) SetType(_ mutator.Type) {}
+
+// Status returns the mutator.Status of the mutant.Mutator.
+func (m *HigherOrderMutator) Status() mutator.Status {
+	return m.status
+}
+
+// SetStatus sets the mutator.Status of the mutant.Mutator.
+func (m *HigherOrderMutator) SetStatus(s mutator.Status) {
+	m.status = s
+}
+
+// SetRace implements the mutator.RaceRecorder interface.
+func (m *HigherOrderMutator) SetRace(race bool) {
+	m.race = race
+}
+
+// Race implements the mutator.RaceRecorder interface.
+func (m *HigherOrderMutator) Race() bool {
+	return m.race
+}
+
+// Position returns the token.Position of the first combined mutant.
+func (m *HigherOrderMutator) Position() token.Position {
+	return m.mutants[0].Position()
+}
+
+// Pos returns the token.Pos of the first combined mutant.
+func (m *HigherOrderMutator) Pos() token.Pos {
+	return m.mutants[0].Pos()
+}
+
+// Pkg returns the package of the first combined mutant.
+func (m *HigherOrderMutator) Pkg() string {
+	return m.mutants[0].Pkg()
+}
+
+// Description lists the combined mutants.
+func (m *HigherOrderMutator) Description() string {
+	s := make([]string, 0, len(m.mutants))
+	for _, fo := range m.mutants {
+		d := fo.Type().String()
+		if desc := mutator.Description(fo); desc != "" {
+			d += " (" + desc + ")"
+		}
+		s = append(s, fmt.Sprintf("%s at %s", d, fo.Position()))
+	}
+
+	return strings.Join(s, " + ")
+}
+
+// Apply rewrites the AST with all the combined mutants and overwrites the
+// source code files with the mutated ones, restoring the AST right after.
+// The original files are stored in order to allow Rollback to put them
+// back later. If a file cannot be written, the ones already written are
+// put back before returning the error.
+func (m *HigherOrderMutator) Apply() error {
+	files := m.files()
+	for _, f := range files {
+		fileLock(f).Lock()
+	}
+	defer func() {
+		for _, f := range files {
+			fileLock(f).Unlock()
+		}
+	}()
+
+	m.origFiles = make(map[string][]byte, len(files))
+	for _, f := range files {
+		filename := filepath.Join(m.workDir, f)
+		orig, err := os.ReadFile(filename)
+		if err != nil {
+			_ = m.Rollback()
+
+			return err
+		}
+		m.origFiles[filename] = orig
+		if err := m.writeFile(f, filename); err != nil {
+			_ = m.Rollback()
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeFile applies the mutants of the file f and writes it.
+func (m *HigherOrderMutator) writeFile(f, filename string) (err error) {
+	var restores []func()
+	defer func() {
+		for i := len(restores) - 1; i >= 0; i-- {
+			restores[i]()
+		}
+	}()
+	// The rewrites of nested nodes may not be applicable together.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("conflicting mutations in %s: %v", f, r)
+		}
+	}()
+
+	var set *token.FileSet
+	var file *ast.File
+	for _, fo := range m.mutants {
+		if fo.Position().Filename != f {
+			continue
+		}
+		set, file = fo.source()
+		restores = append(restores, fo.mutate())
+	}
+
+	return writeMutatedFile(filename, set, file)
+}
+
+// files returns the files of the combined mutants, sorted.
+func (m *HigherOrderMutator) files() []string {
+	var files []string
+	for _, fo := range m.mutants {
+		f := fo.Position().Filename
+		i := sort.SearchStrings(files, f)
+		if i < len(files) && files[i] == f {
+			continue
+		}
+		files = append(files, "")
+		copy(files[i+1:], files[i:])
+		files[i] = f
+	}
+
+	return files
+}
+
+// Rollback puts back the original files after the test and cleans up the
+// HigherOrderMutator to free memory.
+func (m *HigherOrderMutator) Rollback() error {
+	defer func() {
+		m.origFiles = nil
+	}()
+	for filename, orig := range m.origFiles {
+		if err := os.WriteFile(filename, orig, 0600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetWorkdir sets the base path on which to Apply and Rollback operations.
+func (m *HigherOrderMutator) SetWorkdir(path string) {
+	m.workDir = path
+	for _, fo := range m.mutants {
+		fo.SetWorkdir(path)
+	}
+}
+
+// Workdir returns the current working dir in which the Mutator will apply its mutations.
+func (m *HigherOrderMutator) Workdir() string {
+	return m.workDir
+}
@@ -0,0 +1,289 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-maxhub/gremlins/core/configuration"
+	"github.com/go-maxhub/gremlins/core/coverage"
+	"github.com/go-maxhub/gremlins/core/engine"
+	"github.com/go-maxhub/gremlins/core/gomodule"
+	"github.com/go-maxhub/gremlins/core/mutator"
+)
+
+func TestHigherOrderStrategies(t *testing.T) {
+	src := "package main\n\nfunc f(a, b int) int {\n\treturn a + b\n}\n\nfunc g(a, b, c int) int {\n\treturn a * b + c\n}\n"
+	testCases := []struct {
+		name     string
+		strategy string
+		want     []string
+	}{
+		{
+			name:     "same-function combines the mutants of the same function",
+			strategy: engine.HOMSameFunction,
+			want: []string{
+				"ARITHMETIC_BASE at main.go:4:11",
+				"ARITHMETIC_BASE at main.go:8:11 + ARITHMETIC_BASE at main.go:8:15",
+			},
+		},
+		{
+			name:     "adjacent combines the mutants following each other",
+			strategy: engine.HOMAdjacent,
+			want: []string{
+				"ARITHMETIC_BASE at main.go:4:11 + ARITHMETIC_BASE at main.go:8:11",
+				"ARITHMETIC_BASE at main.go:8:15",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			h := engine.HigherOrder{Order: 2, Strategy: tc.strategy}
+			mutants := findHigherOrder(t, h, fstest.MapFS{"main.go": {Data: []byte(src)}})
+
+			var got []string
+			for _, m := range mutants {
+				if m.Type() == mutator.HigherOrder {
+					got = append(got, mutator.Description(m))
+
+					continue
+				}
+				got = append(got, m.Type().String()+" at "+m.Position().String())
+			}
+			sort.Strings(got)
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestHigherOrderRandom(t *testing.T) {
+	mapFS := fstest.MapFS{
+		"a.go":     {Data: []byte("package main\n\nfunc f(a, b int) int {\n\treturn a + b + a\n}\n")},
+		"b.go":     {Data: []byte("package main\n\nfunc g(a, b int) int {\n\treturn a * b\n}\n")},
+		"sub/c.go": {Data: []byte("package sub\n\nfunc h(a, b int) int {\n\treturn a * b * a\n}\n")},
+	}
+	h := engine.HigherOrder{Order: 3, Strategy: engine.HOMRandom}
+	mutants := findHigherOrder(t, h, mapFS)
+
+	firstOrder := 0
+	var homs []*engine.HigherOrderMutator
+	for _, m := range mutants {
+		if hom, ok := m.(*engine.HigherOrderMutator); ok {
+			homs = append(homs, hom)
+
+			continue
+		}
+		firstOrder++
+	}
+	if len(homs) != 1 || firstOrder != 2 {
+		t.Fatalf("expected 1 higher-order and 2 first-order mutants, got %d and %d", len(homs), firstOrder)
+	}
+	for _, m := range homs[0].Mutants() {
+		if m.Pkg() != "example.com" {
+			t.Errorf("expected the mutants of the same package, got %s", m.Pkg())
+		}
+	}
+}
+
+func TestHigherOrderApplyAndRollback(t *testing.T) {
+	srcA := "package main\n\nfunc f(a, b int) int {\n\treturn a + b\n}\n"
+	srcB := "package main\n\nfunc g(a, b int) int {\n\treturn a * b\n}\n"
+	mapFS := fstest.MapFS{
+		"a.go": {Data: []byte(srcA)},
+		"b.go": {Data: []byte(srcB)},
+	}
+	h := engine.HigherOrder{Order: 2, Strategy: engine.HOMRandom}
+	mutants := findHigherOrder(t, h, mapFS)
+	if len(mutants) != 1 || mutants[0].Type() != mutator.HigherOrder {
+		t.Fatalf("expected a single higher-order mutant, got %d", len(mutants))
+	}
+	hom := mutants[0]
+
+	workdir := t.TempDir()
+	for name, src := range map[string]string{"a.go": srcA, "b.go": srcB} {
+		if err := os.WriteFile(filepath.Join(workdir, name), []byte(src), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	hom.SetWorkdir(workdir)
+	if err := hom.Apply(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"a.go": "package main\n\nfunc f(a, b int) int {\n\treturn a - b\n}\n",
+		"b.go": "package main\n\nfunc g(a, b int) int {\n\treturn a / b\n}\n",
+	}
+	for name, w := range want {
+		got, _ := os.ReadFile(filepath.Join(workdir, name))
+		if string(got) != w {
+			t.Errorf(cmp.Diff(w, string(got)))
+		}
+	}
+
+	if err := hom.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	for name, w := range map[string]string{"a.go": srcA, "b.go": srcB} {
+		got, _ := os.ReadFile(filepath.Join(workdir, name))
+		if string(got) != w {
+			t.Errorf(cmp.Diff(w, string(got)))
+		}
+	}
+}
+
+func TestHigherOrderSkipsClashingMutants(t *testing.T) {
+	src := "package main\n\nimport \"sync\"\n\nfunc f(mu *sync.Mutex) {\n\tmu.Lock()\n\tmu.Unlock()\n}\n"
+	h := engine.HigherOrder{Order: 2, Strategy: engine.HOMSameFunction}
+	mapFS := fstest.MapFS{"main.go": {Data: []byte(src)}}
+
+	// REMOVE_LOCK removes the unlock as well, so that it cannot be
+	// combined with the removal of the unlock by STATEMENT_REMOVAL.
+	mutants := findHigherOrderWith(t, h, mapFS, mutator.RemoveLock, mutator.StatementRemoval)
+
+	var got []string
+	for _, m := range mutants {
+		got = append(got, m.Type().String()+" at "+m.Position().String())
+	}
+	want := []string{
+		"REMOVE_LOCK at main.go:6:2",
+		"STATEMENT_REMOVAL at main.go:6:2",
+		"STATEMENT_REMOVAL at main.go:7:2",
+	}
+	sort.Strings(got)
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestHigherOrderApplyRestoresOnFailure(t *testing.T) {
+	srcA := "package main\n\nfunc f(a, b int) int {\n\treturn a + b\n}\n"
+	srcB := "package main\n\nfunc g(a, b int) int {\n\treturn a * b\n}\n"
+	mapFS := fstest.MapFS{
+		"a.go": {Data: []byte(srcA)},
+		"b.go": {Data: []byte(srcB)},
+	}
+	h := engine.HigherOrder{Order: 2, Strategy: engine.HOMRandom}
+	mutants := findHigherOrder(t, h, mapFS)
+	if len(mutants) != 1 || mutants[0].Type() != mutator.HigherOrder {
+		t.Fatalf("expected a single higher-order mutant, got %d", len(mutants))
+	}
+	hom := mutants[0]
+
+	// b.go is missing, so that the Apply fails after writing a.go.
+	workdir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(workdir, "a.go"), []byte(srcA), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	hom.SetWorkdir(workdir)
+	if err := hom.Apply(); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	got, _ := os.ReadFile(filepath.Join(workdir, "a.go"))
+	if string(got) != srcA {
+		t.Errorf(cmp.Diff(srcA, string(got)))
+	}
+}
+
+func TestNewHigherOrder(t *testing.T) {
+	testCases := []struct {
+		name     string
+		strategy string
+		want     engine.HigherOrder
+		wantErr  bool
+	}{
+		{
+			name: "it defaults to random",
+			want: engine.HigherOrder{Order: 2, Strategy: engine.HOMRandom},
+		},
+		{
+			name:     "it accepts the known strategies",
+			strategy: engine.HOMAdjacent,
+			want:     engine.HigherOrder{Order: 2, Strategy: engine.HOMAdjacent},
+		},
+		{
+			name:     "it rejects the unknown strategies",
+			strategy: "nearest",
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			viperSet(map[string]any{
+				configuration.UnleashHOMOrderKey:    2,
+				configuration.UnleashHOMStrategyKey: tc.strategy,
+			})
+			defer viperReset()
+
+			got, err := engine.NewHigherOrder()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+// findHigherOrder runs the Engine in dry run on the files, with all their
+// lines covered and only ARITHMETIC_BASE enabled, combining the mutants
+// found with the given HigherOrder.
+func findHigherOrder(t *testing.T, h engine.HigherOrder, mapFS fstest.MapFS) []mutator.Mutator {
+	t.Helper()
+
+	return findHigherOrderWith(t, h, mapFS, mutator.ArithmeticBase)
+}
+
+// findHigherOrderWith is like findHigherOrder, with the given mutator.Type
+// enabled instead.
+func findHigherOrderWith(t *testing.T, h engine.HigherOrder, mapFS fstest.MapFS, mts ...mutator.Type) []mutator.Mutator {
+	t.Helper()
+	enabled := map[string]any{configuration.UnleashDryRunKey: true}
+	for _, m := range mutator.Types {
+		enabled[configuration.MutantTypeEnabledKey(m)] = false
+	}
+	for _, m := range mts {
+		enabled[configuration.MutantTypeEnabledKey(m)] = true
+	}
+	viperSet(enabled)
+	defer viperReset()
+
+	profile := make(coverage.Profile)
+	for name := range mapFS {
+		profile[name] = []coverage.Block{{StartLine: 1, EndLine: 100}}
+	}
+	mod := gomodule.GoModule{Name: "example.com", Root: ".", CallingDir: "."}
+	codeData := engine.CodeData{Cov: profile}
+	eng := engine.New(mod, codeData, newJobDealerStub(t), engine.WithDirFs(mapFS), engine.WithHigherOrder(h))
+
+	return eng.Run(context.Background()).Mutants
+}
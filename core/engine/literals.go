@@ -0,0 +1,223 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// findNumericLiterals replaces the integer and floating point literals n
+// with n+1, n-1, 0 and 1.
+//
+// The replacements equal to the original value, or negative, are skipped.
+// The literals in array length positions and in case lists, where they
+// could clash with another case, are skipped, as well as the ones in
+// const declarations using iota, where the mutation would propagate to
+// the whole block.
+func findNumericLiterals(n ast.Node, c *cursor) []NodeMutation {
+	lit, ok := n.(*ast.BasicLit)
+	if !ok || (lit.Kind != token.INT && lit.Kind != token.FLOAT) {
+		return nil
+	}
+	if c.inArrayLen(lit) || c.inCaseList(lit) || c.inIotaSpec() {
+		return nil
+	}
+	v := constant.MakeFromLiteral(lit.Value, lit.Kind, 0)
+	if v.Kind() == constant.Unknown {
+		return nil
+	}
+
+	one := constant.MakeInt64(1)
+	candidates := []constant.Value{
+		constant.BinaryOp(v, token.ADD, one),
+		constant.BinaryOp(v, token.SUB, one),
+		constant.MakeInt64(0),
+		one,
+	}
+	var mutations []NodeMutation
+	seen := map[string]bool{lit.Value: true}
+	for _, cv := range candidates {
+		if constant.Sign(cv) < 0 || constant.Compare(cv, token.EQL, v) {
+			continue
+		}
+		value := numericLiteral(cv, lit.Kind)
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		mutations = append(mutations, NodeMutation{
+			Pos:    lit.Pos(),
+			Mutate: setLiteral(lit, value),
+		})
+	}
+
+	return mutations
+}
+
+// stringSentinel is the value given to the empty string literals.
+const stringSentinel = `"gremlins"`
+
+// findStringLiterals replaces the non-empty string literals with the empty
+// string, and the empty ones with a sentinel value.
+//
+// Struct tags, import paths and case lists are skipped, as well as the
+// declarations annotated with the //go: directives taking string
+// arguments, which often refer to them.
+func findStringLiterals(n ast.Node, c *cursor) []NodeMutation {
+	lit, ok := n.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil
+	}
+	switch p := c.parent().(type) {
+	case *ast.ImportSpec:
+		return nil
+	case *ast.Field:
+		if p.Tag == lit {
+			return nil
+		}
+	}
+	if c.inCaseList(lit) || c.inDirective() {
+		return nil
+	}
+	value := `""`
+	if s, err := strconv.Unquote(lit.Value); err == nil && s == "" {
+		value = stringSentinel
+	}
+
+	return []NodeMutation{{
+		Pos:    lit.Pos(),
+		Mutate: setLiteral(lit, value),
+	}}
+}
+
+// numericLiteral formats the constant.Value as a literal of the given kind.
+// Floating point literals always keep the decimal point, so that the type
+// of the untyped constant doesn't change.
+func numericLiteral(v constant.Value, kind token.Token) string {
+	if kind == token.INT {
+		return v.ExactString()
+	}
+	f, _ := constant.Float64Val(constant.ToFloat(v))
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eIN") {
+		s += ".0"
+	}
+
+	return s
+}
+
+// setLiteral returns the function that sets the value of the literal.
+func setLiteral(lit *ast.BasicLit, value string) func() func() {
+	return func() func() {
+		orig := lit.Value
+		lit.Value = value
+
+		return func() {
+			lit.Value = orig
+		}
+	}
+}
+
+// inArrayLen tells if the node is part of the length of an array type.
+func (c *cursor) inArrayLen(n ast.Node) bool {
+	for _, a := range c.stack {
+		arr, ok := a.(*ast.ArrayType)
+		if ok && arr.Len != nil && arr.Len.Pos() <= n.Pos() && n.End() <= arr.Len.End() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// inCaseList tells if the node is part of the expressions of a case
+// clause, whose values must not be duplicated.
+func (c *cursor) inCaseList(n ast.Node) bool {
+	for _, a := range c.stack {
+		clause, ok := a.(*ast.CaseClause)
+		if !ok {
+			continue
+		}
+		for _, e := range clause.List {
+			if e.Pos() <= n.Pos() && n.End() <= e.End() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// stringDirectives are the //go: directives taking string arguments.
+var stringDirectives = []string{"//go:embed ", "//go:linkname "}
+
+// inDirective tells if the visited node is part of a variable or constant
+// declaration whose doc comment contains one of the stringDirectives. The
+// directives of the functions, such as //go:noinline, are not considered.
+func (c *cursor) inDirective() bool {
+	for _, a := range c.stack {
+		var doc *ast.CommentGroup
+		switch d := a.(type) {
+		case *ast.GenDecl:
+			doc = d.Doc
+		case *ast.ValueSpec:
+			doc = d.Doc
+		}
+		if doc == nil {
+			continue
+		}
+		for _, cm := range doc.List {
+			for _, dir := range stringDirectives {
+				if strings.HasPrefix(cm.Text, dir) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// inIotaSpec tells if the visited node is part of a const specification
+// whose values use iota.
+func (c *cursor) inIotaSpec() bool {
+	for _, a := range c.stack {
+		spec, ok := a.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+		for _, v := range spec.Values {
+			usesIota := false
+			ast.Inspect(v, func(n ast.Node) bool {
+				if id, ok := n.(*ast.Ident); ok && id.Name == "iota" {
+					usesIota = true
+				}
+
+				return !usesIota
+			})
+			if usesIota {
+				return true
+			}
+		}
+	}
+
+	return false
+}
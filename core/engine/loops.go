@@ -0,0 +1,88 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// findLoopSkips inserts a break at the start of the body of the for and
+// range loops, so that they run zero times.
+//
+// The infinite loops ending a function with results are left alone, since
+// the break would make the function miss its terminating statement.
+func findLoopSkips(n ast.Node, c *cursor) []NodeMutation {
+	body := loopBody(n)
+	if body == nil || c.terminatesFunc(n) {
+		return nil
+	}
+
+	return []NodeMutation{{
+		Pos: body.Lbrace,
+		Mutate: func() func() {
+			return insertStmt(body, 0, &ast.BranchStmt{TokPos: body.Lbrace, Tok: token.BREAK})
+		},
+	}}
+}
+
+// findLoopOnces appends a break at the end of the body of the for and
+// range loops, so that they run exactly once. The bodies already ending
+// with a return or a branch statement are skipped, since the break would
+// never be reached.
+func findLoopOnces(n ast.Node, c *cursor) []NodeMutation {
+	body := loopBody(n)
+	if body == nil || c.terminatesFunc(n) {
+		return nil
+	}
+	if l := len(body.List); l > 0 {
+		switch body.List[l-1].(type) {
+		case *ast.ReturnStmt, *ast.BranchStmt:
+			return nil
+		}
+	}
+
+	return []NodeMutation{{
+		Pos: body.Rbrace,
+		Mutate: func() func() {
+			return insertStmt(body, len(body.List), &ast.BranchStmt{TokPos: body.Rbrace, Tok: token.BREAK})
+		},
+	}}
+}
+
+func loopBody(n ast.Node) *ast.BlockStmt {
+	switch l := n.(type) {
+	case *ast.ForStmt:
+		return l.Body
+	case *ast.RangeStmt:
+		return l.Body
+	default:
+		return nil
+	}
+}
+
+// terminatesFunc tells if the loop is an infinite for loop ending a
+// function with results.
+func (c *cursor) terminatesFunc(n ast.Node) bool {
+	loop, ok := n.(*ast.ForStmt)
+	if !ok || loop.Cond != nil {
+		return false
+	}
+	f := c.enclosingFunc()
+
+	return f != nil && f.Results != nil && len(f.Results.List) > 0 && c.endsFunc(loop)
+}
@@ -61,6 +61,72 @@ var TokenMutantType = map[token.Token][]mutator.Type{
 	token.XOR_ASSIGN:     {mutator.RemoveSelfAssignments, mutator.InvertBitwiseAssignments},
 }
 
+// nodeMutantType is the mapping from each mutator.Type that rewrites the
+// AST to the nodeFinder that discovers its mutations.
+var nodeMutantType = map[mutator.Type]nodeFinder{
+	mutator.APISwap:               findAPISwaps,
+	mutator.ArgumentSwap:          findArgumentSwaps,
+	mutator.ArithmeticReplacement: findArithmeticReplacements,
+	mutator.BuiltinCopy:           findBuiltinCopies,
+	mutator.BuiltinLength:         findBuiltinLengths,
+	mutator.BuiltinMinMax:         findBuiltinMinMaxes,
+	mutator.ChannelBuffer:         findChannelBuffers,
+	mutator.ContextBackground:     findContextBackgrounds,
+	mutator.ContextWrapRemoval:    findContextWrapRemovals,
+	mutator.EmptyCase:             findEmptyCases,
+	mutator.ErrorCheckNegation:    findErrorCheckNegations,
+	mutator.ErrorGuardRemoval:     findErrorGuardRemovals,
+	mutator.ErrorReturnNil:        findErrorReturnNils,
+	mutator.ErrorUnwrap:           findErrorUnwraps,
+	mutator.FieldRemoval:          findFieldRemovals,
+	mutator.ForceConditions:       findForceConditions,
+	mutator.IndexShift:            findIndexShifts,
+	mutator.InlineGoroutine:       findInlineGoroutines,
+	mutator.InvertBooleans:        findInvertBooleans,
+	mutator.LogicalReplacement:    findLogicalReplacements,
+	mutator.LoopOnce:              findLoopOnces,
+	mutator.LoopSkip:              findLoopSkips,
+	mutator.NumericLiteral:        findNumericLiterals,
+	mutator.OnceDo:                findOnceDos,
+	mutator.RLockToLock:           findRLockToLocks,
+	mutator.RelationalReplacement: findRelationalReplacements,
+	mutator.RemoveAppend:          findRemoveAppends,
+	mutator.RemoveCase:            findRemoveCases,
+	mutator.RemoveClose:           findRemoveCloses,
+	mutator.RemoveDefault:         findRemoveDefaults,
+	mutator.RemoveDefer:           findRemoveDefers,
+	mutator.RemoveDelete:          findRemoveDeletes,
+	mutator.RemoveLock:            findRemoveLocks,
+	mutator.RemoveMapWrite:        findRemoveMapWrites,
+	mutator.RemoveNot:             findRemoveNots,
+	mutator.RemovePanic:           findRemovePanics,
+	mutator.RemoveRecover:         findRemoveRecovers,
+	mutator.ReturnValues:          findReturnValues,
+	mutator.SelectDefault:         findSelectDefaults,
+	mutator.SliceBoundRemoval:     findSliceBoundRemovals,
+	mutator.SliceBoundary:         findSliceBoundaries,
+	mutator.StatementRemoval:      findStatementRemovals,
+	mutator.StringLiteral:         findStringLiterals,
+	mutator.WaitGroupAdd:          findWaitGroupAdds,
+}
+
+// untypedNodeMutantTypes are the mutator.Type of nodeMutantType whose
+// nodeFinder doesn't rely on the types.Info: the packages aren't type
+// checked if only these are enabled.
+var untypedNodeMutantTypes = map[mutator.Type]bool{
+	mutator.EmptyCase:          true,
+	mutator.FieldRemoval:       true,
+	mutator.InlineGoroutine:    true,
+	mutator.LogicalReplacement: true,
+	mutator.RemoveCase:         true,
+	mutator.RemoveDefault:      true,
+	mutator.RemoveDefer:        true,
+	mutator.RemoveNot:          true,
+	mutator.SelectDefault:      true,
+	mutator.SliceBoundRemoval:  true,
+	mutator.StatementRemoval:   true,
+}
+
 var tokenMutations = map[mutator.Type]map[token.Token]token.Token{
 	mutator.ArithmeticBase: {
 		token.ADD: token.SUB,
@@ -19,6 +19,7 @@ package engine
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // NodeToken is the reference to the actualToken that will be mutated during
@@ -61,6 +62,77 @@ func NewTokenNode(n ast.Node) (*NodeToken, bool) {
 	}, true
 }
 
+// NodeMutation is a single rewrite of the AST that a NodeMutator can apply.
+type NodeMutation struct {
+	// Mutate rewrites the AST in place and returns the function that
+	// puts the original nodes back.
+	Mutate func() (restore func())
+
+	// Pos is the position at which the mutation is reported.
+	Pos token.Pos
+
+	// Desc optionally describes the mutation in the report.
+	Desc string
+}
+
+// nodeFinder looks for the NodeMutation of a mutator.Type that can be
+// applied on the ast.Node being visited.
+type nodeFinder func(n ast.Node, c *cursor) []NodeMutation
+
+// cursor holds the context in which an ast.Node is visited during the walk
+// of a file.
+type cursor struct {
+	file     *ast.File
+	info     *types.Info
+	stack    []ast.Node
+	apiSwaps apiSwapTable
+}
+
+// parent returns the direct ancestor of the visited ast.Node, or nil if the
+// node is the root of the walk.
+func (c *cursor) parent() ast.Node {
+	if len(c.stack) == 0 {
+		return nil
+	}
+
+	return c.stack[len(c.stack)-1]
+}
+
+// File implements the Cursor interface.
+func (c *cursor) File() *ast.File {
+	return c.file
+}
+
+// Info implements the Cursor interface.
+func (c *cursor) Info() *types.Info {
+	return c.info
+}
+
+// Parent implements the Cursor interface.
+func (c *cursor) Parent() ast.Node {
+	return c.parent()
+}
+
+// Stack implements the Cursor interface.
+func (c *cursor) Stack() []ast.Node {
+	return c.stack
+}
+
+// enclosingFunc returns the type of the innermost function, declaration or
+// literal, that contains the visited ast.Node.
+func (c *cursor) enclosingFunc() *ast.FuncType {
+	for i := len(c.stack) - 1; i >= 0; i-- {
+		switch f := c.stack[i].(type) {
+		case *ast.FuncLit:
+			return f.Type
+		case *ast.FuncDecl:
+			return f.Type
+		}
+	}
+
+	return nil
+}
+
 // Tok returns the reference to the token.Token.
 func (n *NodeToken) Tok() token.Token {
 	return *n.tok
@@ -0,0 +1,161 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"os"
+	"path/filepath"
+
+	"github.com/go-maxhub/gremlins/core/mutator"
+)
+
+// NodeMutator is a mutator.Mutator that rewrites a portion of the AST
+// instead of swapping a single token.Token.
+//
+// The rewrite is performed by the NodeMutation it has been created from,
+// which changes the AST in place and returns the function that puts the
+// original nodes back.
+// As for the TokenMutator, the AST is shared among mutants, so the same
+// per-file locking applies.
+type NodeMutator struct {
+	pkg        string
+	fs         *token.FileSet
+	file       *ast.File
+	mutation   NodeMutation
+	workDir    string
+	origFile   []byte
+	status     mutator.Status
+	mutantType mutator.Type
+	race       bool
+}
+
+// NewNodeMutant initialises a NodeMutator.
+func NewNodeMutant(pkg string, set *token.FileSet, file *ast.File, m NodeMutation) *NodeMutator {
+	return &NodeMutator{
+		pkg:      pkg,
+		fs:       set,
+		file:     file,
+		mutation: m,
+	}
+}
+
+// Type returns the mutator.Type of the mutant.Mutator.
+func (m *NodeMutator) Type() mutator.Type {
+	return m.mutantType
+}
+
+// SetType sets the mutator.Type of the mutant.Mutator.
+func (m *NodeMutator) SetType(mt mutator.Type) {
+	m.mutantType = mt
+}
+
+// Status returns the mutator.Status of the mutant.Mutator.
+func (m *NodeMutator) Status() mutator.Status {
+	return m.status
+}
+
+// SetStatus sets the mutator.Status of the mutant.Mutator.
+func (m *NodeMutator) SetStatus(s mutator.Status) {
+	m.status = s
+}
+
+// SetRace implements the mutator.RaceRecorder interface.
+func (m *NodeMutator) SetRace(race bool) {
+	m.race = race
+}
+
+// Race implements the mutator.RaceRecorder interface.
+func (m *NodeMutator) Race() bool {
+	return m.race
+}
+
+// Position returns the token.Position where the NodeMutator resides.
+func (m *NodeMutator) Position() token.Position {
+	return m.fs.Position(m.mutation.Pos)
+}
+
+// Pos returns the token.Pos where the NodeMutator resides.
+func (m *NodeMutator) Pos() token.Pos {
+	return m.mutation.Pos
+}
+
+// Pkg returns the package name to which the mutant belongs.
+func (m *NodeMutator) Pkg() string {
+	return m.pkg
+}
+
+// Description returns the description of the NodeMutation, if any.
+func (m *NodeMutator) Description() string {
+	return m.mutation.Desc
+}
+
+// Apply rewrites the AST, overwrites the source code file with the mutated
+// one and restores the AST right after. The original file is stored in the
+// NodeMutator in order to allow Rollback to put it back later.
+func (m *NodeMutator) Apply() error {
+	fileLock(m.Position().Filename).Lock()
+	defer fileLock(m.Position().Filename).Unlock()
+
+	filename := filepath.Join(m.workDir, m.Position().Filename)
+	var err error
+	m.origFile, err = os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	restore := m.mutate()
+	// Restore the AST even if the write fails, other mutants rely on it.
+	defer restore()
+
+	return writeMutatedFile(filename, m.fs, m.file)
+}
+
+// mutate rewrites the AST and returns the function that restores it.
+func (m *NodeMutator) mutate() func() {
+	return m.mutation.Mutate()
+}
+
+// source returns the file the NodeMutator operates on.
+func (m *NodeMutator) source() (*token.FileSet, *ast.File) {
+	return m.fs, m.file
+}
+
+// Rollback puts back the original file after the test and cleans up the
+// NodeMutator to free memory.
+func (m *NodeMutator) Rollback() error {
+	defer m.resetOrigFile()
+	filename := filepath.Join(m.workDir, m.Position().Filename)
+
+	return os.WriteFile(filename, m.origFile, 0600)
+}
+
+// SetWorkdir sets the base path on which to Apply and Rollback operations.
+func (m *NodeMutator) SetWorkdir(path string) {
+	m.workDir = path
+}
+
+// Workdir returns the current working dir in which the Mutator will apply its mutations.
+func (m *NodeMutator) Workdir() string {
+	return m.workDir
+}
+
+func (m *NodeMutator) resetOrigFile() {
+	var zeroByte []byte
+	m.origFile = zeroByte
+}
@@ -0,0 +1,1099 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-maxhub/gremlins/core/configuration"
+	"github.com/go-maxhub/gremlins/core/engine"
+	"github.com/go-maxhub/gremlins/core/gomodule"
+	"github.com/go-maxhub/gremlins/core/mutator"
+)
+
+func TestNodeMutantApplyAndRollback(t *testing.T) {
+	testCases := []struct {
+		name       string
+		src        string
+		want       []string
+		mutantType mutator.Type
+	}{
+		{
+			name:       "STATEMENT_REMOVAL removes calls and assignments",
+			mutantType: mutator.StatementRemoval,
+			src:        "package main\n\nfunc main() {\n\ta := 1\n\ta = 2\n\tprintln(a)\n}\n",
+			want: []string{
+				"package main\n\nfunc main() {\n\ta := 1\n\n\tprintln(a)\n}\n",
+				"package main\n\nfunc main() {\n\ta := 1\n\ta = 2\n\n}\n",
+			},
+		},
+		{
+			name:       "STATEMENT_REMOVAL skips short variable declarations and init statements",
+			mutantType: mutator.StatementRemoval,
+			src:        "package main\n\nfunc main() {\n\tfor a := 0; a < 1; a = a + 1 {\n\t}\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "STATEMENT_REMOVAL skips the assignments to the blank identifier",
+			mutantType: mutator.StatementRemoval,
+			src:        "package main\n\nfunc f(x, y int) {\n\t_ = x\n\t_, _ = x, y\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "RETURN_VALUES returns the zero values of the results",
+			mutantType: mutator.ReturnValues,
+			src: "package main\n\ntype point struct{ x int }\n\n" +
+				"func f() (int, string, bool, error, point, []int) {\n" +
+				"\treturn 1, \"a\", true, nil, point{x: 1}, []int{1}\n}\n",
+			want: []string{
+				"package main\n\ntype point struct{ x int }\n\n" +
+					"func f() (int, string, bool, error, point, []int) {\n" +
+					"\treturn 0, \"\", false, nil, point{}, nil\n}\n",
+			},
+		},
+		{
+			name:       "RETURN_VALUES replaces multi-value calls and named results",
+			mutantType: mutator.ReturnValues,
+			src:        "package main\n\nfunc f() (a, b float64) {\n\treturn g()\n}\n\nfunc g() (float64, float64) {\n\treturn 0, 0\n}\n",
+			want: []string{
+				"package main\n\nfunc f() (a, b float64) {\n\treturn 0, 0\n}\n\nfunc g() (float64, float64) {\n\treturn 0, 0\n}\n",
+			},
+		},
+		{
+			name:       "RETURN_VALUES uses the type parameters",
+			mutantType: mutator.ReturnValues,
+			src:        "package main\n\nfunc f[T any](v T) T {\n\treturn v\n}\n",
+			want: []string{
+				"package main\n\nfunc f[T any](v T) T {\n\treturn *new(T)\n}\n",
+			},
+		},
+		{
+			name:       "RETURN_VALUES skips bare returns",
+			mutantType: mutator.ReturnValues,
+			src:        "package main\n\nfunc f() (a int) {\n\ta = 1\n\treturn\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "ERROR_RETURN_NIL returns nil instead of errors",
+			mutantType: mutator.ErrorReturnNil,
+			src:        "package main\n\nfunc f(err error) (int, error) {\n\tif err != nil {\n\t\treturn 0, err\n\t}\n\treturn 1, nil\n}\n",
+			want: []string{
+				"package main\n\nfunc f(err error) (int, error) {\n\tif err != nil {\n\t\treturn 0, nil\n\t}\n\treturn 1, nil\n}\n",
+			},
+		},
+		{
+			name:       "ERROR_GUARD_REMOVAL drops the body of the error guard",
+			mutantType: mutator.ErrorGuardRemoval,
+			src:        "package main\n\nfunc f(err error) int {\n\tif nil != err {\n\t\treturn 0\n\t}\n\tif err == nil {\n\t\treturn 1\n\t}\n\treturn 2\n}\n",
+			want: []string{
+				"package main\n\nfunc f(err error) int {\n\tif nil != err {\n\n\t}\n\tif err == nil {\n\t\treturn 1\n\t}\n\treturn 2\n}\n",
+			},
+		},
+		{
+			name:       "ERROR_CHECK_NEGATION negates errors.Is and errors.As",
+			mutantType: mutator.ErrorCheckNegation,
+			src: "package main\n\nimport stderr \"errors\"\n\nvar errTest = stderr.New(\"test\")\n\n" +
+				"func f(err error) bool {\n\tvar target *stderr.Error\n\treturn stderr.Is(err, errTest) && stderr.As(err, &target)\n}\n",
+			want: []string{
+				"package main\n\nimport stderr \"errors\"\n\nvar errTest = stderr.New(\"test\")\n\n" +
+					"func f(err error) bool {\n\tvar target *stderr.Error\n\treturn !stderr.Is(err, errTest) && stderr.As(err, &target)\n}\n",
+				"package main\n\nimport stderr \"errors\"\n\nvar errTest = stderr.New(\"test\")\n\n" +
+					"func f(err error) bool {\n\tvar target *stderr.Error\n\treturn stderr.Is(err, errTest) && !stderr.As(err, &target)\n}\n",
+			},
+		},
+		{
+			name:       "ERROR_CHECK_NEGATION resolves the package through the imports",
+			mutantType: mutator.ErrorCheckNegation,
+			src:        "package main\n\ntype pkg struct{}\n\nfunc (pkg) Is(error, error) bool { return true }\n\nvar errors pkg\n\nfunc f(err error) bool {\n\treturn errors.Is(err, err)\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "ERROR_CHECK_NEGATION skips the calls of go and defer statements",
+			mutantType: mutator.ErrorCheckNegation,
+			src:        "package main\n\nimport \"errors\"\n\nfunc f(err error) {\n\tdefer errors.Is(err, err)\n\tgo errors.Is(err, err)\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "ERROR_UNWRAP downgrades %w to %v",
+			mutantType: mutator.ErrorUnwrap,
+			src:        "package main\n\nimport \"fmt\"\n\nfunc f(err error) error {\n\treturn fmt.Errorf(\"100%% failed: %w\", err)\n}\n",
+			want: []string{
+				"package main\n\nimport \"fmt\"\n\nfunc f(err error) error {\n\treturn fmt.Errorf(\"100%% failed: %v\", err)\n}\n",
+			},
+		},
+		{
+			name:       "NUMERIC_LITERAL replaces integers with n+1, n-1, 0 and 1",
+			mutantType: mutator.NumericLiteral,
+			src:        "package main\n\nvar a = 5\n",
+			want: []string{
+				"package main\n\nvar a = 6\n",
+				"package main\n\nvar a = 4\n",
+				"package main\n\nvar a = 0\n",
+				"package main\n\nvar a = 1\n",
+			},
+		},
+		{
+			name:       "NUMERIC_LITERAL skips duplicated and negative values",
+			mutantType: mutator.NumericLiteral,
+			src:        "package main\n\nvar a = 0\n",
+			want:       []string{"package main\n\nvar a = 1\n"},
+		},
+		{
+			name:       "NUMERIC_LITERAL keeps floats as floats",
+			mutantType: mutator.NumericLiteral,
+			src:        "package main\n\nvar a = 1.5\n",
+			want: []string{
+				"package main\n\nvar a = 2.5\n",
+				"package main\n\nvar a = 0.5\n",
+				"package main\n\nvar a = 0.0\n",
+				"package main\n\nvar a = 1.0\n",
+			},
+		},
+		{
+			name:       "NUMERIC_LITERAL skips array lengths and iota constants",
+			mutantType: mutator.NumericLiteral,
+			src:        "package main\n\nconst (\n\tA = iota + 1\n\tB\n)\n\nvar a [2]int\n",
+			want:       nil,
+		},
+		{
+			name:       "NUMERIC_LITERAL skips the case lists",
+			mutantType: mutator.NumericLiteral,
+			src:        "package main\n\nfunc f(a int) {\n\tswitch a {\n\tcase 0:\n\tcase 1:\n\t}\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "STRING_LITERAL empties strings and fills empty ones",
+			mutantType: mutator.StringLiteral,
+			src:        "package main\n\nvar a, b = \"key\", ``\n",
+			want: []string{
+				"package main\n\nvar a, b = \"\", ``\n",
+				"package main\n\nvar a, b = \"key\", \"gremlins\"\n",
+			},
+		},
+		{
+			name:       "STRING_LITERAL skips struct tags, import paths and directives",
+			mutantType: mutator.StringLiteral,
+			src: "package main\n\nimport _ \"embed\"\n\n" +
+				"type t struct {\n\tA int `json:\"a\"`\n}\n\n//go:embed source.go\nvar src string\n\n" +
+				"//go:linkname name runtime.name\nvar name = \"runtime.name\"\n",
+			want: nil,
+		},
+		{
+			name:       "STRING_LITERAL mutates the functions annotated with directives",
+			mutantType: mutator.StringLiteral,
+			src:        "package main\n\n//go:noinline\nfunc f() string {\n\treturn \"a\"\n}\n",
+			want: []string{
+				"package main\n\n//go:noinline\nfunc f() string {\n\treturn \"\"\n}\n",
+			},
+		},
+		{
+			name:       "STRING_LITERAL skips the case lists",
+			mutantType: mutator.StringLiteral,
+			src:        "package main\n\nfunc f(s string) {\n\tswitch s {\n\tcase \"\":\n\tcase \"gremlins\":\n\t}\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "INVERT_BOOLEANS flips true and false",
+			mutantType: mutator.InvertBooleans,
+			src:        "package main\n\nvar a, b = true, false\n",
+			want: []string{
+				"package main\n\nvar a, b = false, false\n",
+				"package main\n\nvar a, b = true, true\n",
+			},
+		},
+		{
+			name:       "INVERT_BOOLEANS skips shadowed identifiers",
+			mutantType: mutator.InvertBooleans,
+			src:        "package main\n\nfunc f() int {\n\ttrue := 1\n\treturn true\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "FORCE_CONDITIONS forces if and for conditions",
+			mutantType: mutator.ForceConditions,
+			src:        "package main\n\nfunc f(a, b bool) {\n\tif a && b {\n\t}\n\tfor true {\n\t}\n\tfor {\n\t}\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a, b bool) {\n\tif true {\n\t}\n\tfor true {\n\t}\n\tfor {\n\t}\n}\n",
+				"package main\n\nfunc f(a, b bool) {\n\tif false {\n\t}\n\tfor true {\n\t}\n\tfor {\n\t}\n}\n",
+				"package main\n\nfunc f(a, b bool) {\n\tif a && b {\n\t}\n\tfor false {\n\t}\n\tfor {\n\t}\n}\n",
+			},
+		},
+		{
+			name:       "REMOVE_NOT removes the logical negation",
+			mutantType: mutator.RemoveNot,
+			src:        "package main\n\nfunc f(a, b bool) bool {\n\treturn !a || !(a && b)\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a, b bool) bool {\n\treturn a || !(a && b)\n}\n",
+				"package main\n\nfunc f(a, b bool) bool {\n\treturn !a || (a && b)\n}\n",
+			},
+		},
+		{
+			name:       "REMOVE_CASE removes the case clauses",
+			mutantType: mutator.RemoveCase,
+			src:        "package main\n\nfunc f(a int) {\n\tswitch a {\n\tcase 1:\n\t\tprintln(1)\n\tcase 2:\n\tdefault:\n\t}\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a int) {\n\tswitch a {\n\n\tcase 2:\n\tdefault:\n\t}\n}\n",
+				"package main\n\nfunc f(a int) {\n\tswitch a {\n\tcase 1:\n\t\tprintln(1)\n\n\tdefault:\n\t}\n}\n",
+			},
+		},
+		{
+			name:       "REMOVE_CASE keeps the variable bound by type switches used",
+			mutantType: mutator.RemoveCase,
+			src:        "package main\n\nfunc f(a any) {\n\tswitch v := a.(type) {\n\tcase int:\n\t\tprintln(v)\n\tcase string:\n\t}\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a any) {\n\tswitch v := a.(type) {\n\tcase int:\n\t\tprintln(v)\n\n\t}\n}\n",
+			},
+		},
+		{
+			name:       "EMPTY_CASE empties the non-empty clauses",
+			mutantType: mutator.EmptyCase,
+			src:        "package main\n\nfunc f(a any) {\n\tswitch a.(type) {\n\tcase int:\n\t\tprintln(1)\n\tcase string:\n\tdefault:\n\t\tprintln(2)\n\t}\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a any) {\n\tswitch a.(type) {\n\tcase int:\n\n\tcase string:\n\tdefault:\n\t\tprintln(2)\n\t}\n}\n",
+				"package main\n\nfunc f(a any) {\n\tswitch a.(type) {\n\tcase int:\n\t\tprintln(1)\n\tcase string:\n\tdefault:\n\n\t}\n}\n",
+			},
+		},
+		{
+			name:       "EMPTY_CASE skips the clauses holding the only uses of variables",
+			mutantType: mutator.EmptyCase,
+			src: "package main\n\nfunc f(a any, ch chan int) {\n\tswitch v := a.(type) {\n\tcase int:\n\t\tprintln(v)\n\t}\n" +
+				"\tselect {\n\tcase v := <-ch:\n\t\tprintln(v)\n\t}\n}\n",
+			want: nil,
+		},
+		{
+			name:       "REMOVE_DEFAULT removes the default clause",
+			mutantType: mutator.RemoveDefault,
+			src:        "package main\n\nfunc f(a int) {\n\tswitch a {\n\tcase 1:\n\tdefault:\n\t}\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a int) {\n\tswitch a {\n\tcase 1:\n\n\t}\n}\n",
+			},
+		},
+		{
+			name:       "REMOVE_DEFAULT removes the default clause of select statements",
+			mutantType: mutator.RemoveDefault,
+			src:        "package main\n\nfunc f(ch chan int) {\n\tselect {\n\tcase <-ch:\n\tdefault:\n\t}\n}\n",
+			want: []string{
+				"package main\n\nfunc f(ch chan int) {\n\tselect {\n\tcase <-ch:\n\n\t}\n}\n",
+			},
+		},
+		{
+			name:       "REMOVE_DEFAULT keeps the variable bound by type switches used",
+			mutantType: mutator.RemoveDefault,
+			src:        "package main\n\nfunc f(a any) {\n\tswitch v := a.(type) {\n\tcase int:\n\tdefault:\n\t\tprintln(v)\n\t}\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "SLICE_BOUNDARY shifts the slice bounds",
+			mutantType: mutator.SliceBoundary,
+			src:        "package main\n\nfunc f(s []int, i int) []int {\n\treturn s[0:i]\n}\n",
+			want: []string{
+				"package main\n\nfunc f(s []int, i int) []int {\n\treturn s[0+1 : i]\n}\n",
+				"package main\n\nfunc f(s []int, i int) []int {\n\treturn s[0 : i+1]\n}\n",
+				"package main\n\nfunc f(s []int, i int) []int {\n\treturn s[0 : i-1]\n}\n",
+			},
+		},
+		{
+			name:       "SLICE_BOUND_REMOVAL drops the slice bounds",
+			mutantType: mutator.SliceBoundRemoval,
+			src:        "package main\n\nfunc f(s []int) ([]int, []int) {\n\treturn s[1:2], s[1:2:3]\n}\n",
+			want: []string{
+				"package main\n\nfunc f(s []int) ([]int, []int) {\n\treturn s[:2], s[1:2:3]\n}\n",
+				"package main\n\nfunc f(s []int) ([]int, []int) {\n\treturn s[1:], s[1:2:3]\n}\n",
+				"package main\n\nfunc f(s []int) ([]int, []int) {\n\treturn s[1:2], s[:2:3]\n}\n",
+			},
+		},
+		{
+			name:       "INDEX_SHIFT shifts the index of slices, arrays and strings",
+			mutantType: mutator.IndexShift,
+			src:        "package main\n\nfunc f(s string, m map[int]int, i int) (byte, int) {\n\treturn s[i], m[i]\n}\n",
+			want: []string{
+				"package main\n\nfunc f(s string, m map[int]int, i int) (byte, int) {\n\treturn s[i+1], m[i]\n}\n",
+				"package main\n\nfunc f(s string, m map[int]int, i int) (byte, int) {\n\treturn s[i-1], m[i]\n}\n",
+			},
+		},
+		{
+			name:       "INLINE_GOROUTINE runs the goroutines synchronously",
+			mutantType: mutator.InlineGoroutine,
+			src:        "package main\n\nfunc f(ch chan int) {\n\tgo func() {\n\t\tch <- 1\n\t}()\n}\n",
+			want: []string{
+				"package main\n\nfunc f(ch chan int) {\n\tfunc() {\n\t\tch <- 1\n\t}()\n}\n",
+			},
+		},
+		{
+			name:       "REMOVE_DEFER removes the defer statements",
+			mutantType: mutator.RemoveDefer,
+			src:        "package main\n\nfunc f(ch chan int) {\n\tdefer close(ch)\n\tch <- 1\n}\n",
+			want: []string{
+				"package main\n\nfunc f(ch chan int) {\n\n\tch <- 1\n}\n",
+			},
+		},
+		{
+			name:       "REMOVE_LOCK removes the lock and unlock pairs",
+			mutantType: mutator.RemoveLock,
+			src: "package main\n\nimport \"sync\"\n\ntype s struct {\n\tsync.RWMutex\n}\n\nvar n int\n\n" +
+				"func (v *s) f() {\n\tv.Lock()\n\tdefer v.Unlock()\n\tn++\n}\n\n" +
+				"func (v *s) g() int {\n\tv.RLock()\n\tm := n\n\tv.RUnlock()\n\treturn m\n}\n",
+			want: []string{
+				"package main\n\nimport \"sync\"\n\ntype s struct {\n\tsync.RWMutex\n}\n\nvar n int\n\n" +
+					"func (v *s) f() {\n\n\tn++\n}\n\n" +
+					"func (v *s) g() int {\n\tv.RLock()\n\tm := n\n\tv.RUnlock()\n\treturn m\n}\n",
+				"package main\n\nimport \"sync\"\n\ntype s struct {\n\tsync.RWMutex\n}\n\nvar n int\n\n" +
+					"func (v *s) f() {\n\tv.Lock()\n\tdefer v.Unlock()\n\tn++\n}\n\n" +
+					"func (v *s) g() int {\n\n\tm := n\n\n\treturn m\n}\n",
+			},
+		},
+		{
+			name:       "REMOVE_LOCK skips unpaired locks",
+			mutantType: mutator.RemoveLock,
+			src:        "package main\n\nimport \"sync\"\n\nfunc f(mu *sync.Mutex) {\n\tmu.Lock()\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "RLOCK_TO_LOCK turns read locks into write locks",
+			mutantType: mutator.RLockToLock,
+			src:        "package main\n\nimport \"sync\"\n\nfunc f(mu *sync.RWMutex) {\n\tmu.RLock()\n\tdefer mu.RUnlock()\n}\n",
+			want: []string{
+				"package main\n\nimport \"sync\"\n\nfunc f(mu *sync.RWMutex) {\n\tmu.Lock()\n\tdefer mu.Unlock()\n}\n",
+			},
+		},
+		{
+			name:       "WAITGROUP_ADD decrements the delta",
+			mutantType: mutator.WaitGroupAdd,
+			src:        "package main\n\nimport \"sync\"\n\nfunc f(wg *sync.WaitGroup, n int) {\n\twg.Add(n)\n}\n",
+			want: []string{
+				"package main\n\nimport \"sync\"\n\nfunc f(wg *sync.WaitGroup, n int) {\n\twg.Add(n - 1)\n}\n",
+			},
+		},
+		{
+			name:       "ONCE_DO calls the function directly",
+			mutantType: mutator.OnceDo,
+			src:        "package main\n\nimport \"sync\"\n\nvar once sync.Once\n\nfunc f() {\n\tonce.Do(func() {})\n}\n",
+			want: []string{
+				"package main\n\nimport \"sync\"\n\nvar once sync.Once\n\nfunc f() {\n\tfunc() {}()\n}\n",
+			},
+		},
+		{
+			name:       "CHANNEL_BUFFER changes the buffer size of channels",
+			mutantType: mutator.ChannelBuffer,
+			src:        "package main\n\nvar a, b, c = make(chan int, 2), make(chan int, 0), make([]int, 2)\n",
+			want: []string{
+				"package main\n\nvar a, b, c = make(chan int, 0), make(chan int, 0), make([]int, 2)\n",
+				"package main\n\nvar a, b, c = make(chan int, 2+1), make(chan int, 0), make([]int, 2)\n",
+				"package main\n\nvar a, b, c = make(chan int, 2), make(chan int, 0+1), make([]int, 2)\n",
+			},
+		},
+		{
+			name:       "REMOVE_CLOSE removes the calls to close",
+			mutantType: mutator.RemoveClose,
+			src:        "package main\n\nfunc f(a, b chan int) {\n\tdefer close(a)\n\tclose(b)\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a, b chan int) {\n\n\tclose(b)\n}\n",
+				"package main\n\nfunc f(a, b chan int) {\n\tdefer close(a)\n\n}\n",
+			},
+		},
+		{
+			name:       "SELECT_DEFAULT adds a default clause to the blocking selects",
+			mutantType: mutator.SelectDefault,
+			src: "package main\n\nfunc f(ch chan int) {\n\tselect {\n\tcase <-ch:\n\t}\n" +
+				"\tselect {\n\tcase ch <- 1:\n\tdefault:\n\t}\n}\n",
+			want: []string{
+				"package main\n\nfunc f(ch chan int) {\n\tselect {\n\tcase <-ch:\n\tdefault:\n\t}\n" +
+					"\tselect {\n\tcase ch <- 1:\n\tdefault:\n\t}\n}\n",
+			},
+		},
+		{
+			name:       "CONTEXT_BACKGROUND replaces the context arguments",
+			mutantType: mutator.ContextBackground,
+			src: "package main\n\nimport ctxpkg \"context\"\n\n" +
+				"func f(ctx ctxpkg.Context) {\n\tg(ctx, 1)\n\tg(ctxpkg.TODO(), 2)\n}\n\nfunc g(ctx ctxpkg.Context, n int) {\n}\n",
+			want: []string{
+				"package main\n\nimport ctxpkg \"context\"\n\n" +
+					"func f(ctx ctxpkg.Context) {\n\tg(ctxpkg.Background(), 1)\n\tg(ctxpkg.TODO(), 2)\n}\n\nfunc g(ctx ctxpkg.Context, n int) {\n}\n",
+			},
+		},
+		{
+			name:       "CONTEXT_WRAP_REMOVAL keeps the parent context",
+			mutantType: mutator.ContextWrapRemoval,
+			src: "package main\n\nimport (\n\t\"context\"\n\t\"time\"\n)\n\n" +
+				"func f(ctx context.Context) {\n\tctx, cancel := context.WithTimeout(ctx, time.Second)\n\tdefer cancel()\n\t_ = ctx\n}\n",
+			want: []string{
+				"package main\n\nimport (\n\t\"context\"\n\t\"time\"\n)\n\n" +
+					"func f(ctx context.Context) {\n\tctx, cancel := ctx, func() {}\n\tdefer cancel()\n\t_ = ctx\n}\n",
+			},
+		},
+		{
+			name:       "ARGUMENT_SWAP swaps the adjacent arguments of the same type",
+			mutantType: mutator.ArgumentSwap,
+			src:        "package main\n\nfunc f(a, b int, s string) {\n\tg(a, b, s)\n\tg(a, a, s)\n\tcopy([]int{}, []int{})\n}\n\nfunc g(x, y int, s string) {\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a, b int, s string) {\n\tg(b, a, s)\n\tg(a, a, s)\n\tcopy([]int{}, []int{})\n}\n\nfunc g(x, y int, s string) {\n}\n",
+			},
+		},
+		{
+			name:       "ARGUMENT_SWAP skips the spread calls",
+			mutantType: mutator.ArgumentSwap,
+			src:        "package main\n\nfunc f(a, b []any) {\n\tg(a, b...)\n}\n\nfunc g(x []any, y ...any) {\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "REMOVE_APPEND drops the appended elements",
+			mutantType: mutator.RemoveAppend,
+			src:        "package main\n\nfunc f(s []int) []int {\n\ts = append(s, 1, 2)\n\treturn s\n}\n",
+			want: []string{
+				"package main\n\nfunc f(s []int) []int {\n\ts = s\n\treturn s\n}\n",
+			},
+		},
+		{
+			name:       "REMOVE_DELETE removes the calls to delete",
+			mutantType: mutator.RemoveDelete,
+			src:        "package main\n\nfunc f(m map[int]int) {\n\tdelete(m, 1)\n}\n",
+			want: []string{
+				"package main\n\nfunc f(m map[int]int) {\n\n}\n",
+			},
+		},
+		{
+			name:       "REMOVE_MAP_WRITE removes the map assignments",
+			mutantType: mutator.RemoveMapWrite,
+			src:        "package main\n\nfunc f(m map[int]int, s []int) {\n\tm[1] += 1\n\ts[0] = 1\n}\n",
+			want: []string{
+				"package main\n\nfunc f(m map[int]int, s []int) {\n\n\ts[0] = 1\n}\n",
+			},
+		},
+		{
+			name:       "FIELD_REMOVAL removes the keyed elements",
+			mutantType: mutator.FieldRemoval,
+			src:        "package main\n\nvar m = map[string]int{\"a\": 1, \"b\": 2}\n\nvar s = []int{1, 2}\n",
+			want: []string{
+				"package main\n\nvar m = map[string]int{\"b\": 2}\n\nvar s = []int{1, 2}\n",
+				"package main\n\nvar m = map[string]int{\"a\": 1}\n\nvar s = []int{1, 2}\n",
+			},
+		},
+		{
+			name:       "API_SWAP swaps the functions of the table",
+			mutantType: mutator.APISwap,
+			src:        "package main\n\nimport \"strings\"\n\nfunc f(s string) bool {\n\treturn strings.HasPrefix(s, \"a\")\n}\n",
+			want: []string{
+				"package main\n\nimport \"strings\"\n\nfunc f(s string) bool {\n\treturn strings.HasSuffix(s, \"a\")\n}\n",
+			},
+		},
+		{
+			name:       "API_SWAP swaps the builtins and the methods",
+			mutantType: mutator.APISwap,
+			src:        "package main\n\nimport \"time\"\n\nfunc f(a, b time.Time, x, y int) bool {\n\treturn a.Before(b) && min(x, y) > 0\n}\n",
+			want: []string{
+				"package main\n\nimport \"time\"\n\nfunc f(a, b time.Time, x, y int) bool {\n\treturn a.After(b) && min(x, y) > 0\n}\n",
+				"package main\n\nimport \"time\"\n\nfunc f(a, b time.Time, x, y int) bool {\n\treturn a.Before(b) && max(x, y) > 0\n}\n",
+			},
+		},
+		{
+			name:       "API_SWAP negates the functions of the table",
+			mutantType: mutator.APISwap,
+			src:        "package main\n\nimport \"bytes\"\n\nfunc f(a, b []byte) bool {\n\treturn bytes.Equal(a, b)\n}\n",
+			want: []string{
+				"package main\n\nimport \"bytes\"\n\nfunc f(a, b []byte) bool {\n\treturn !bytes.Equal(a, b)\n}\n",
+			},
+		},
+		{
+			name:       "API_SWAP doesn't negate the calls of go and defer statements",
+			mutantType: mutator.APISwap,
+			src:        "package main\n\nimport \"bytes\"\n\nfunc f(a, b []byte) {\n\tdefer bytes.Equal(a, b)\n\tgo bytes.Equal(a, b)\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "API_SWAP skips the identifiers shadowing a package",
+			mutantType: mutator.APISwap,
+			src:        "package main\n\nvar strings struct{ HasPrefix func(s, p string) bool }\n\nfunc f(s string) bool {\n\treturn strings.HasPrefix(s, \"a\")\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "RELATIONAL_REPLACEMENT replaces the operator with all the other relational ones",
+			mutantType: mutator.RelationalReplacement,
+			src:        "package main\n\nfunc f(a, b int) bool {\n\treturn a < b\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a, b int) bool {\n\treturn a <= b\n}\n",
+				"package main\n\nfunc f(a, b int) bool {\n\treturn a > b\n}\n",
+				"package main\n\nfunc f(a, b int) bool {\n\treturn a >= b\n}\n",
+				"package main\n\nfunc f(a, b int) bool {\n\treturn a == b\n}\n",
+				"package main\n\nfunc f(a, b int) bool {\n\treturn a != b\n}\n",
+			},
+		},
+		{
+			name:       "RELATIONAL_REPLACEMENT only swaps the equality of unordered operands",
+			mutantType: mutator.RelationalReplacement,
+			src:        "package main\n\nfunc f(a, b *int) bool {\n\treturn a == b\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a, b *int) bool {\n\treturn a != b\n}\n",
+			},
+		},
+		{
+			name:       "ARITHMETIC_REPLACEMENT replaces the operator with all the other arithmetic ones",
+			mutantType: mutator.ArithmeticReplacement,
+			src:        "package main\n\nfunc f(a, b int) int {\n\treturn a + b\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a, b int) int {\n\treturn a - b\n}\n",
+				"package main\n\nfunc f(a, b int) int {\n\treturn a * b\n}\n",
+				"package main\n\nfunc f(a, b int) int {\n\treturn a / b\n}\n",
+				"package main\n\nfunc f(a, b int) int {\n\treturn a % b\n}\n",
+			},
+		},
+		{
+			name:       "ARITHMETIC_REPLACEMENT skips the remainder of floats and the divisions by zero",
+			mutantType: mutator.ArithmeticReplacement,
+			src:        "package main\n\nfunc f(a float64) float64 {\n\treturn a - 0\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a float64) float64 {\n\treturn a + 0\n}\n",
+				"package main\n\nfunc f(a float64) float64 {\n\treturn a * 0\n}\n",
+			},
+		},
+		{
+			name:       "ARITHMETIC_REPLACEMENT skips string concatenations",
+			mutantType: mutator.ArithmeticReplacement,
+			src:        "package main\n\nfunc f(a, b string) string {\n\treturn a + b\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "LOGICAL_REPLACEMENT swaps the operator and replaces the expression",
+			mutantType: mutator.LogicalReplacement,
+			src:        "package main\n\nfunc f(a, b bool) bool {\n\treturn a && b\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a, b bool) bool {\n\treturn a || b\n}\n",
+				"package main\n\nfunc f(a, b bool) bool {\n\treturn a\n}\n",
+				"package main\n\nfunc f(a, b bool) bool {\n\treturn b\n}\n",
+				"package main\n\nfunc f(a, b bool) bool {\n\treturn true\n}\n",
+				"package main\n\nfunc f(a, b bool) bool {\n\treturn false\n}\n",
+			},
+		},
+		{
+			name:       "BUILTIN_LENGTH shifts len and cap by one",
+			mutantType: mutator.BuiltinLength,
+			src:        "package main\n\nfunc f(s []int) int {\n\treturn len(s) * cap(s)\n}\n",
+			want: []string{
+				"package main\n\nfunc f(s []int) int {\n\treturn (len(s) - 1) * cap(s)\n}\n",
+				"package main\n\nfunc f(s []int) int {\n\treturn (len(s) + 1) * cap(s)\n}\n",
+				"package main\n\nfunc f(s []int) int {\n\treturn len(s) * (cap(s) - 1)\n}\n",
+				"package main\n\nfunc f(s []int) int {\n\treturn len(s) * (cap(s) + 1)\n}\n",
+			},
+		},
+		{
+			name:       "BUILTIN_LENGTH skips the array lengths and the constant zero lengths",
+			mutantType: mutator.BuiltinLength,
+			src:        "package main\n\nconst s = \"ab\"\n\nvar a [len(s)]int\n\nvar n = len(\"\")\n",
+			want: []string{
+				"package main\n\nconst s = \"ab\"\n\nvar a [len(s)]int\n\nvar n = len(\"\") + 1\n",
+			},
+		},
+		{
+			name:       "BUILTIN_MIN_MAX swaps min and max",
+			mutantType: mutator.BuiltinMinMax,
+			src:        "package main\n\nfunc f(a, b int) int {\n\treturn min(a, b) + max(a, b)\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a, b int) int {\n\treturn max(a, b) + max(a, b)\n}\n",
+				"package main\n\nfunc f(a, b int) int {\n\treturn min(a, b) + min(a, b)\n}\n",
+			},
+		},
+		{
+			name:       "BUILTIN_MIN_MAX skips the functions shadowing the builtins",
+			mutantType: mutator.BuiltinMinMax,
+			src:        "package main\n\nfunc min(a, b int) int {\n\treturn a\n}\n\nvar n = min(1, 2)\n",
+			want:       nil,
+		},
+		{
+			name:       "BUILTIN_COPY drops the last element of the source",
+			mutantType: mutator.BuiltinCopy,
+			src:        "package main\n\ntype buf struct{ data []byte }\n\nfunc f(dst []byte, b buf) int {\n\treturn copy(dst, b.data)\n}\n",
+			want: []string{
+				"package main\n\ntype buf struct{ data []byte }\n\nfunc f(dst []byte, b buf) int {\n\treturn copy(dst, b.data[:len(b.data)-1])\n}\n",
+			},
+		},
+		{
+			name:       "BUILTIN_COPY skips the sources which are not simple operands",
+			mutantType: mutator.BuiltinCopy,
+			src:        "package main\n\nfunc f(dst []byte, g func() []byte) int {\n\treturn copy(dst, g())\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "REMOVE_RECOVER removes the recover of the deferred functions",
+			mutantType: mutator.RemoveRecover,
+			src: "package main\n\nfunc f() {\n\tdefer func() {\n\t\trecover()\n\t}()\n}\n\n" +
+				"func g() (err error) {\n\tdefer func() {\n\t\tif r := recover(); r != nil {\n\t\t\terr = nil\n\t\t}\n\t}()\n\n\treturn nil\n}\n",
+			want: []string{
+				"package main\n\nfunc f() {\n\tdefer func() {\n\n\t}()\n}\n\n" +
+					"func g() (err error) {\n\tdefer func() {\n\t\tif r := recover(); r != nil {\n\t\t\terr = nil\n\t\t}\n\t}()\n\n\treturn nil\n}\n",
+				"package main\n\nfunc f() {\n\tdefer func() {\n\t\trecover()\n\t}()\n}\n\n" +
+					"func g() (err error) {\n\tdefer func() {\n\t\tif r := interface{}(nil); r != nil {\n\t\t\terr = nil\n\t\t}\n\t}()\n\n\treturn nil\n}\n",
+			},
+		},
+		{
+			name:       "REMOVE_RECOVER skips the functions which are not deferred",
+			mutantType: mutator.RemoveRecover,
+			src:        "package main\n\nfunc f() {\n\tr := recover()\n\tgo func() {\n\t\trecover()\n\t}()\n\tprintln(r)\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "REMOVE_PANIC removes the calls to panic",
+			mutantType: mutator.RemovePanic,
+			src:        "package main\n\nfunc f(a int) int {\n\tif a < 0 {\n\t\tpanic(\"negative\")\n\t}\n\n\treturn a\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a int) int {\n\tif a < 0 {\n\n\t}\n\n\treturn a\n}\n",
+			},
+		},
+		{
+			name:       "REMOVE_PANIC skips the panics ending a function with results",
+			mutantType: mutator.RemovePanic,
+			src: "package main\n\nfunc f(a int) int {\n\tswitch a {\n\tcase 0:\n\t\treturn 1\n\tdefault:\n\t\tpanic(a)\n\t}\n}\n\n" +
+				"func g() {\n\tpanic(\"g\")\n}\n",
+			want: []string{
+				"package main\n\nfunc f(a int) int {\n\tswitch a {\n\tcase 0:\n\t\treturn 1\n\tdefault:\n\t\tpanic(a)\n\t}\n}\n\n" +
+					"func g() {\n\n}\n",
+			},
+		},
+		{
+			name:       "LOOP_SKIP breaks at the start of the loops",
+			mutantType: mutator.LoopSkip,
+			src:        "package main\n\nfunc f(s []int) {\n\tfor _, v := range s {\n\t\tprintln(v)\n\t}\n\tfor i := 0; i < 3; i++ {\n\t}\n}\n",
+			want: []string{
+				"package main\n\nfunc f(s []int) {\n\tfor _, v := range s {\n\t\tbreak\n\t\tprintln(v)\n\t}\n\tfor i := 0; i < 3; i++ {\n\t}\n}\n",
+				"package main\n\nfunc f(s []int) {\n\tfor _, v := range s {\n\t\tprintln(v)\n\t}\n\tfor i := 0; i < 3; i++ {\n\t\tbreak\n\t}\n}\n",
+			},
+		},
+		{
+			name:       "LOOP_SKIP skips the infinite loops ending a function with results",
+			mutantType: mutator.LoopSkip,
+			src:        "package main\n\nfunc f(ch chan int) int {\n\tfor {\n\t\tif v := <-ch; v > 0 {\n\t\t\treturn v\n\t\t}\n\t}\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "LOOP_ONCE breaks at the end of the loops",
+			mutantType: mutator.LoopOnce,
+			src:        "package main\n\nfunc f(s []int) {\n\tfor _, v := range s {\n\t\tprintln(v)\n\t}\n}\n",
+			want: []string{
+				"package main\n\nfunc f(s []int) {\n\tfor _, v := range s {\n\t\tprintln(v)\n\t\tbreak\n\t}\n}\n",
+			},
+		},
+		{
+			name:       "LOOP_ONCE skips the loops ending with a return or a branch",
+			mutantType: mutator.LoopOnce,
+			src:        "package main\n\nfunc f(s []int) int {\n\tfor _, v := range s {\n\t\tif v > 0 {\n\t\t\tcontinue\n\t\t}\n\n\t\treturn v\n\t}\n\n\treturn 0\n}\n",
+			want:       nil,
+		},
+		{
+			name:       "INDEX_SHIFT skips the instantiations of generic types",
+			mutantType: mutator.IndexShift,
+			src:        "package main\n\ntype Vec[T any] []T\n\nvar v Vec[int]\n",
+			want:       nil,
+		},
+		{
+			name:       "FORCE_CONDITIONS keeps the variables declared by the init statement used",
+			mutantType: mutator.ForceConditions,
+			src:        "package main\n\nfunc f(m map[int]bool, a bool) {\n\tif _, ok := m[0]; ok && a {\n\t}\n}\n",
+			want: []string{
+				"package main\n\nfunc f(m map[int]bool, a bool) {\n\tif _, ok := m[0]; (ok && a) || true {\n\t}\n}\n",
+				"package main\n\nfunc f(m map[int]bool, a bool) {\n\tif _, ok := m[0]; (ok && a) && false {\n\t}\n}\n",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := applyAll(t, tc.mutantType, tc.src)
+
+			sort.Strings(got)
+			sort.Strings(tc.want)
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestNodeMutantDescription(t *testing.T) {
+	testCases := []struct {
+		name       string
+		src        string
+		want       []string
+		mutantType mutator.Type
+	}{
+		{
+			name:       "REMOVE_CASE names the removed case",
+			mutantType: mutator.RemoveCase,
+			src: "package main\n\nfunc f(a int, ch chan int) {\n" +
+				"\tswitch a {\n\tcase 1, 2:\n\t\tprintln(a)\n\tdefault:\n\t}\n" +
+				"\tselect {\n\tcase v, ok := <-ch:\n\t\tprintln(v, ok)\n\tcase ch <- a:\n\t}\n}\n",
+			want: []string{"case 1, 2", "case ch <- a", "case v, ok := <-ch"},
+		},
+		{
+			name:       "FIELD_REMOVAL names the removed field",
+			mutantType: mutator.FieldRemoval,
+			src:        "package main\n\ntype config struct{ Timeout, Retries int }\n\nvar c = config{Timeout: 1, Retries: 3}\n",
+			want:       []string{"Retries", "Timeout"},
+		},
+		{
+			name:       "API_SWAP names the replacement",
+			mutantType: mutator.APISwap,
+			src: "package main\n\nimport (\n\t\"bytes\"\n\t\"strings\"\n)\n\n" +
+				"func f(a, b []byte, s string) bool {\n\treturn bytes.Equal(a, b) && strings.HasPrefix(s, \"a\")\n}\n",
+			want: []string{"!bytes.Equal", "strings.HasSuffix"},
+		},
+		{
+			name:       "LOGICAL_REPLACEMENT names the replacement",
+			mutantType: mutator.LogicalReplacement,
+			src:        "package main\n\nfunc f(a, b bool) bool {\n\treturn a || b\n}\n",
+			want:       []string{"&&", "a", "b", "false", "true"},
+		},
+		{
+			name:       "BUILTIN_LENGTH names the shifted call",
+			mutantType: mutator.BuiltinLength,
+			src:        "package main\n\nfunc f(s []int) int {\n\treturn len(s)\n}\n",
+			want:       []string{"len(s) + 1", "len(s) - 1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, m := range findAll(t, tc.mutantType, tc.src) {
+				got = append(got, mutator.Description(m))
+			}
+
+			sort.Strings(got)
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestAPISwapConfiguration(t *testing.T) {
+	settings := map[string]any{
+		configuration.MutantAPISwapPairsKey: [][]string{
+			{"strings.Contains", "strings.ContainsAny"},
+			{"strings.Contains", "bytes.Contains"},
+		},
+		configuration.MutantAPISwapNegationsKey: []string{"strings.Contains"},
+	}
+	src := "package main\n\nimport \"strings\"\n\nfunc f(s string) bool {\n\treturn strings.Contains(s, \"a\")\n}\n"
+
+	var got []string
+	for _, m := range findAllWith(t, settings, mutator.APISwap, src) {
+		got = append(got, mutator.Description(m))
+	}
+
+	want := []string{"!strings.Contains", "strings.ContainsAny"}
+	sort.Strings(got)
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestAPISwapLeavesMinMaxToBuiltinMinMax(t *testing.T) {
+	settings := map[string]any{
+		configuration.MutantTypeEnabledKey(mutator.BuiltinMinMax): true,
+	}
+	src := "package main\n\nimport \"math\"\n\nfunc f(a, b float64) float64 {\n\treturn math.Min(a, b) + min(a, b)\n}\n"
+
+	var got []string
+	for _, m := range findAllWith(t, settings, mutator.APISwap, src) {
+		got = append(got, m.Type().String()+" "+mutator.Description(m))
+	}
+
+	want := []string{"API_SWAP math.Max", "BUILTIN_MIN_MAX max"}
+	sort.Strings(got)
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestErrorReturnNilLeavesZeroReturnsToReturnValues(t *testing.T) {
+	settings := map[string]any{
+		configuration.MutantTypeEnabledKey(mutator.ReturnValues): true,
+	}
+	src := "package main\n\nfunc f(err error) error {\n\treturn err\n}\n\n" +
+		"func g(n int, err error) (int, error) {\n\tif n == 0 {\n\t\treturn 0, err\n\t}\n\treturn n, err\n}\n"
+
+	var got []string
+	for _, m := range findAllWith(t, settings, mutator.ErrorReturnNil, src) {
+		got = append(got, m.Type().String()+" at "+m.Position().String())
+	}
+
+	want := []string{
+		"ERROR_RETURN_NIL at source.go:11:12",
+		"RETURN_VALUES at source.go:11:2",
+		"RETURN_VALUES at source.go:4:2",
+		"RETURN_VALUES at source.go:9:3",
+	}
+	sort.Strings(got)
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestStatementRemovalLeavesSpecificRemovals(t *testing.T) {
+	settings := map[string]any{
+		configuration.MutantTypeEnabledKey(mutator.RemoveClose):    true,
+		configuration.MutantTypeEnabledKey(mutator.RemoveDelete):   true,
+		configuration.MutantTypeEnabledKey(mutator.RemoveMapWrite): true,
+		configuration.MutantTypeEnabledKey(mutator.RemovePanic):    true,
+	}
+	src := "package main\n\nfunc f(m map[int]int, ch chan int) {\n\tdelete(m, 1)\n\tm[1] = 2\n\tprintln()\n\tclose(ch)\n\tpanic(1)\n}\n"
+
+	var got []string
+	for _, m := range findAllWith(t, settings, mutator.StatementRemoval, src) {
+		got = append(got, m.Type().String()+" at "+m.Position().String())
+	}
+
+	want := []string{
+		"REMOVE_CLOSE at source.go:7:2",
+		"REMOVE_DELETE at source.go:4:2",
+		"REMOVE_MAP_WRITE at source.go:5:2",
+		"REMOVE_PANIC at source.go:8:2",
+		"STATEMENT_REMOVAL at source.go:6:2",
+	}
+	sort.Strings(got)
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestReplacementsSkipBaseMutations(t *testing.T) {
+	settings := map[string]any{
+		configuration.MutantTypeEnabledKey(mutator.ConditionalsBoundary): true,
+		configuration.MutantTypeEnabledKey(mutator.ConditionalsNegation): true,
+	}
+	src := "package main\n\nfunc f(a, b int) bool {\n\treturn a < b\n}\n"
+
+	var got []string
+	for _, m := range findAllWith(t, settings, mutator.RelationalReplacement, src) {
+		if m.Type() == mutator.RelationalReplacement {
+			got = append(got, mutator.Description(m))
+		}
+	}
+
+	want := []string{"!=", "==", ">"}
+	sort.Strings(got)
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestExtremeMutations(t *testing.T) {
+	settings := map[string]any{configuration.UnleashExtremeKey: true}
+	src := "package main\n\nimport \"strings\"\n\ntype T struct{}\n\n" +
+		"func (*T) Upper(s string) (string, error) {\n\treturn strings.ToUpper(s) + \"!\", nil\n}\n\n" +
+		"func log(s string) {\n\tprintln(s)\n}\n\n" +
+		"func noop() {\n}\n"
+
+	// The enabled ARITHMETIC_BASE is ignored in the extreme mode.
+	mutants := findAllWith(t, settings, mutator.ArithmeticBase, src)
+
+	var names []string
+	for _, m := range mutants {
+		names = append(names, mutator.Description(m))
+	}
+	wantNames := []string{"(*T).Upper", "log"}
+	if !cmp.Equal(names, wantNames) {
+		t.Errorf(cmp.Diff(wantNames, names))
+	}
+
+	got := applyMutants(t, mutator.Extreme, src, mutants)
+	want := []string{
+		"package main\n\nimport \"strings\"\n\ntype T struct{}\n\n" +
+			"func (*T) Upper(s string) (string, error) {\n\treturn \"\", nil\n\treturn strings.ToUpper(s) + \"!\", nil\n}\n\n" +
+			"func log(s string) {\n\tprintln(s)\n}\n\n" +
+			"func noop() {\n}\n",
+		"package main\n\nimport \"strings\"\n\ntype T struct{}\n\n" +
+			"func (*T) Upper(s string) (string, error) {\n\treturn strings.ToUpper(s) + \"!\", nil\n}\n\n" +
+			"func log(s string) {\n\treturn\n\tprintln(s)\n}\n\n" +
+			"func noop() {\n}\n",
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestExtremeMutationsSkipZeroReturns(t *testing.T) {
+	settings := map[string]any{configuration.UnleashExtremeKey: true}
+	src := "package main\n\n" +
+		"func zero() int {\n\treturn 0\n}\n\n" +
+		"func ok() (bool, error) {\n\treturn false, nil\n}\n\n" +
+		"func none() []int {\n\treturn nil\n}\n\n" +
+		"func one() int {\n\treturn 1\n}\n"
+
+	var got []string
+	for _, m := range findAllWith(t, settings, mutator.Extreme, src) {
+		got = append(got, mutator.Description(m))
+	}
+
+	want := []string{"one"}
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(want, got))
+	}
+}
+
+func TestRules(t *testing.T) {
+	retryZero, err := mutator.Register("retry-zero")
+	if err != nil {
+		t.Fatal(err)
+	}
+	double, err := mutator.Register("double")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules := []configuration.Rule{
+		{Name: "retry-zero", Pattern: "c.Retry(n)", Replacement: "c.Retry(0)", Enabled: true},
+		{Name: "double", Pattern: "a + a", Replacement: "2 * a", Enabled: true},
+		{Name: "disabled", Pattern: "a + b", Replacement: "a - b", Enabled: false},
+		{Name: "invalid", Pattern: "a +", Replacement: "a", Enabled: true},
+		{Name: "api-swap", Pattern: "a + b", Replacement: "a - b", Enabled: true},
+	}
+	settings := map[string]any{configuration.MutantRulesKey: rules}
+
+	testCases := []struct {
+		name       string
+		src        string
+		want       []string
+		mutantType mutator.Type
+	}{
+		{
+			name:       "it rewrites the matching expressions",
+			mutantType: retryZero,
+			src: "package main\n\ntype client struct{}\n\nfunc (client) Retry(n int) {\n}\n\n" +
+				"func f(c client, n int) {\n\tc.Retry(n + 1)\n}\n",
+			want: []string{
+				"package main\n\ntype client struct{}\n\nfunc (client) Retry(n int) {\n}\n\n" +
+					"func f(c client, n int) {\n\tc.Retry(0)\n}\n",
+			},
+		},
+		{
+			name:       "it matches the repeated wildcards with the same expression",
+			mutantType: double,
+			src:        "package main\n\nfunc f(x, y int) int {\n\treturn x + x + y + y*2\n}\n",
+			want: []string{
+				"package main\n\nfunc f(x, y int) int {\n\treturn 2*x + y + y*2\n}\n",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			// The rules are not enabled per type, so the mutants of all
+			// the valid rules are found.
+			var mutants []mutator.Mutator
+			for _, m := range findAllWith(t, settings, tc.mutantType, tc.src) {
+				if m.Type() != retryZero && m.Type() != double {
+					t.Fatalf("unexpected mutant type %s", m.Type())
+				}
+				if m.Type() == tc.mutantType {
+					mutants = append(mutants, m)
+				}
+			}
+			got := applyMutants(t, tc.mutantType, tc.src, mutants)
+
+			if !cmp.Equal(got, tc.want) {
+				t.Errorf(cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+// applyAll finds all the mutants of the given mutator.Type in src, and
+// returns the source code as mutated by each of them. It also checks that
+// each mutant is correctly rolled back.
+func applyAll(t *testing.T, mt mutator.Type, src string) []string {
+	t.Helper()
+
+	return applyMutants(t, mt, src, findAll(t, mt, src))
+}
+
+// applyMutants returns the source code as mutated by each of the mutants
+// found in src, checking that each of them is correctly rolled back.
+func applyMutants(t *testing.T, mt mutator.Type, src string, mutants []mutator.Mutator) []string {
+	t.Helper()
+
+	workdir := t.TempDir()
+	fileFullPath := filepath.Join(workdir, sourceFilename)
+	if err := os.WriteFile(fileFullPath, []byte(src), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, mut := range mutants {
+		if mut.Type() != mt {
+			t.Fatalf("expected only %s mutants, got %s", mt, mut.Type())
+		}
+		mut.SetWorkdir(workdir)
+		if err := mut.Apply(); err != nil {
+			t.Fatal(err)
+		}
+		mutated, err := os.ReadFile(fileFullPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, string(mutated))
+
+		if err := mut.Rollback(); err != nil {
+			t.Fatal(err)
+		}
+		rolledBack, err := os.ReadFile(fileFullPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(rolledBack) != src {
+			t.Fatalf(cmp.Diff(src, string(rolledBack)))
+		}
+	}
+
+	return got
+}
+
+const sourceFilename = "source.go"
+
+// findAll runs the Engine in dry run on src, with only the given
+// mutator.Type enabled, and returns the mutants found.
+func findAll(t *testing.T, mt mutator.Type, src string) []mutator.Mutator {
+	t.Helper()
+
+	return findAllWith(t, nil, mt, src)
+}
+
+// findAllWith is like findAll, with additional configuration settings.
+func findAllWith(t *testing.T, settings map[string]any, mt mutator.Type, src string) []mutator.Mutator {
+	t.Helper()
+	enabled := map[string]any{configuration.UnleashDryRunKey: true}
+	for _, m := range mutator.Types {
+		enabled[configuration.MutantTypeEnabledKey(m)] = m == mt
+	}
+	for k, v := range settings {
+		enabled[k] = v
+	}
+	viperSet(enabled)
+	defer viperReset()
+
+	mapFS := fstest.MapFS{sourceFilename: {Data: []byte(src)}}
+	mod := gomodule.GoModule{Name: "example.com", Root: ".", CallingDir: "."}
+	eng := engine.New(mod, engine.CodeData{}, newJobDealerStub(t), engine.WithDirFs(mapFS))
+
+	return eng.Run(context.Background()).Mutants
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+)
+
+// findRemoveRecovers removes the calls to recover in the deferred function
+// literals, where they can stop a panic. A call used as a statement is
+// removed, while a call used as a value is replaced with a nil interface.
+func findRemoveRecovers(n ast.Node, c *cursor) []NodeMutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 || !isBuiltin(c.info, call, "recover") || !c.inDeferredFunc() {
+		return nil
+	}
+	parent := c.parent()
+	if stmt, ok := parent.(*ast.ExprStmt); ok {
+		list := c.stack[len(c.stack)-2]
+		if !isStmtList(list) {
+			return nil
+		}
+
+		return []NodeMutation{{
+			Pos: call.Pos(),
+			Mutate: func() func() {
+				return removeStmt(list, stmt)
+			},
+		}}
+	}
+	nilInterface := &ast.CallExpr{
+		Fun:  ast.NewIdent("interface{}"),
+		Args: []ast.Expr{ast.NewIdent("nil")},
+	}
+
+	return []NodeMutation{{
+		Pos: call.Pos(),
+		Mutate: func() func() {
+			return replaceNode(parent, call, nilInterface)
+		},
+	}}
+}
+
+// findRemovePanics removes the calls to panic used as statements.
+//
+// A panic ending a function with results is left alone, since the function
+// would then miss its terminating statement.
+func findRemovePanics(n ast.Node, c *cursor) []NodeMutation {
+	stmt, ok := n.(*ast.ExprStmt)
+	if !ok {
+		return nil
+	}
+	call, ok := stmt.X.(*ast.CallExpr)
+	if !ok || !isBuiltin(c.info, call, "panic") {
+		return nil
+	}
+	parent := c.parent()
+	if !isStmtList(parent) {
+		return nil
+	}
+	if f := c.enclosingFunc(); f != nil && f.Results != nil && len(f.Results.List) > 0 && c.endsFunc(stmt) {
+		return nil
+	}
+
+	return []NodeMutation{{
+		Pos: stmt.Pos(),
+		Mutate: func() func() {
+			return removeStmt(parent, stmt)
+		},
+	}}
+}
+
+// inDeferredFunc tells if the innermost function containing the visited
+// ast.Node is a literal called by a defer statement.
+func (c *cursor) inDeferredFunc() bool {
+	for i := len(c.stack) - 1; i >= 0; i-- {
+		switch f := c.stack[i].(type) {
+		case *ast.FuncDecl:
+			return false
+		case *ast.FuncLit:
+			if i < 2 {
+				return false
+			}
+			call, ok := c.stack[i-1].(*ast.CallExpr)
+			if !ok || call.Fun != f {
+				return false
+			}
+			d, ok := c.stack[i-2].(*ast.DeferStmt)
+
+			return ok && d.Call == call
+		}
+	}
+
+	return false
+}
+
+// endsFunc tells if the statement, child of the visited ast.Node, is the
+// last one of the innermost function, including the last statements of
+// the nested blocks, branches and clauses.
+func (c *cursor) endsFunc(stmt ast.Stmt) bool {
+	var node ast.Node = stmt
+	for i := len(c.stack) - 1; i >= 0; i-- {
+		switch p := c.stack[i].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return true
+		case *ast.BlockStmt:
+			if len(p.List) == 0 || p.List[len(p.List)-1] != node {
+				return false
+			}
+		case *ast.CaseClause:
+			if len(p.Body) == 0 || p.Body[len(p.Body)-1] != node {
+				return false
+			}
+		case *ast.CommClause:
+			if len(p.Body) == 0 || p.Body[len(p.Body)-1] != node {
+				return false
+			}
+		case *ast.IfStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt,
+			*ast.ForStmt, *ast.RangeStmt, *ast.LabeledStmt:
+		default:
+			return false
+		}
+		node = c.stack[i]
+	}
+
+	return false
+}
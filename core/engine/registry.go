@@ -0,0 +1,129 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"errors"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sync"
+
+	"github.com/go-maxhub/gremlins/core/configuration"
+	"github.com/go-maxhub/gremlins/core/mutator"
+)
+
+// Finder discovers the NodeMutation of a custom Operator on the ast.Node
+// being visited.
+type Finder func(n ast.Node, c Cursor) []NodeMutation
+
+// Cursor gives the context in which an ast.Node is visited by a Finder.
+type Cursor interface {
+	// File returns the file being walked.
+	File() *ast.File
+
+	// Info returns the type information of the package of the file. The
+	// entries may be missing if the package couldn't be fully type checked.
+	Info() *types.Info
+
+	// Parent returns the direct ancestor of the visited ast.Node, or nil if
+	// the node is the root of the walk.
+	Parent() ast.Node
+
+	// Stack returns the ancestors of the visited ast.Node, from the root of
+	// the walk to its parent.
+	Stack() []ast.Node
+}
+
+// Operator is a custom mutation operator, which can be defined outside of
+// Gremlins and added to the built-in ones with Register.
+type Operator struct {
+	// Name is the name of the mutator.Type of the Operator, such as
+	// ZERO_SLEEP. It gives the name of its flag and of its configuration
+	// key, as for the built-in types.
+	Name string
+
+	// Find discovers the mutations of the Operator.
+	Find Finder
+
+	// New optionally creates the mutator.Mutator applying a NodeMutation.
+	// If nil, the mutations are applied by a NodeMutator.
+	New func(pkg string, set *token.FileSet, file *ast.File, m NodeMutation) mutator.Mutator
+
+	// Enabled tells if the Operator is enabled by default.
+	Enabled bool
+}
+
+type operator struct {
+	Operator
+	mt mutator.Type
+}
+
+var operators struct {
+	sync.RWMutex
+	list []operator
+}
+
+// Register adds a custom Operator to the Engine, returning its dynamic
+// mutator.Type.
+//
+// The operators must be registered before calling cmd.Execute, so that
+// the flags to enable them are created, for example in the main of a
+// custom build of Gremlins:
+//
+//	func main() {
+//		_, err := engine.Register(engine.Operator{Name: "ZERO_SLEEP", Find: findZeroSleeps})
+//		...
+//		err = cmd.Execute(ctx, version)
+//	}
+func Register(op Operator) (mutator.Type, error) {
+	if op.Find == nil {
+		return 0, errors.New("operator has no Finder")
+	}
+	mt, err := mutator.Register(op.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	operators.Lock()
+	defer operators.Unlock()
+	for _, o := range operators.list {
+		if o.mt == mt {
+			return 0, errors.New("operator " + mt.String() + " is already registered")
+		}
+	}
+	operators.list = append(operators.list, operator{Operator: op, mt: mt})
+	configuration.SetDefaultEnabled(mt, op.Enabled)
+
+	return mt, nil
+}
+
+func registeredOperators() []operator {
+	operators.RLock()
+	defer operators.RUnlock()
+
+	return operators.list
+}
+
+// newMutant creates the mutator.Mutator of the Operator.
+func (o operator) newMutant(pkg string, set *token.FileSet, file *ast.File, m NodeMutation) mutator.Mutator {
+	if o.New != nil {
+		return o.New(pkg, set, file, m)
+	}
+
+	return NewNodeMutant(pkg, set, file, m)
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine_test
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/go-maxhub/gremlins/core/configuration"
+	"github.com/go-maxhub/gremlins/core/engine"
+	"github.com/go-maxhub/gremlins/core/mutator"
+)
+
+type customMutant struct {
+	*engine.NodeMutator
+}
+
+func TestRegister(t *testing.T) {
+	// findZeroArgs replaces the integer arguments of the calls with 0.
+	findZeroArgs := func(n ast.Node, c engine.Cursor) []engine.NodeMutation {
+		lit, ok := n.(*ast.BasicLit)
+		if !ok || lit.Kind != token.INT || lit.Value == "0" {
+			return nil
+		}
+		if _, ok := c.Parent().(*ast.CallExpr); !ok {
+			return nil
+		}
+
+		return []engine.NodeMutation{{
+			Pos: lit.Pos(),
+			Mutate: func() func() {
+				orig := lit.Value
+				lit.Value = "0"
+
+				return func() {
+					lit.Value = orig
+				}
+			},
+		}}
+	}
+	mt, err := engine.Register(engine.Operator{
+		Name: "zero-args",
+		Find: findZeroArgs,
+		New: func(pkg string, set *token.FileSet, file *ast.File, m engine.NodeMutation) mutator.Mutator {
+			return customMutant{engine.NewNodeMutant(pkg, set, file, m)}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if configuration.IsDefaultEnabled(mt) {
+		t.Errorf("expected %s to be disabled by default", mt)
+	}
+	if _, err := engine.Register(engine.Operator{Name: "zero-args", Find: findZeroArgs}); err == nil {
+		t.Errorf("expected an error registering the operator twice")
+	}
+	if _, err := engine.Register(engine.Operator{Name: "no-finder"}); err == nil {
+		t.Errorf("expected an error registering an operator without Finder")
+	}
+
+	src := "package main\n\nfunc main() {\n\tprintln(1, 2)\n\ta := 3\n\tprintln(a)\n}\n"
+
+	t.Run("it is disabled by default", func(t *testing.T) {
+		if got := findAll(t, mt, src); len(got) != 0 {
+			t.Errorf("expected no mutants, got %d", len(got))
+		}
+	})
+
+	t.Run("it applies the mutations when enabled", func(t *testing.T) {
+		settings := map[string]any{configuration.MutantTypeEnabledKey(mt): true}
+		mutants := findAllWith(t, settings, mt, src)
+		for _, m := range mutants {
+			if _, ok := m.(customMutant); !ok {
+				t.Errorf("expected the custom mutator.Mutator, got %T", m)
+			}
+		}
+
+		got := applyMutants(t, mt, src, mutants)
+		want := []string{
+			"package main\n\nfunc main() {\n\tprintln(0, 2)\n\ta := 3\n\tprintln(a)\n}\n",
+			"package main\n\nfunc main() {\n\tprintln(1, 0)\n\ta := 3\n\tprintln(a)\n}\n",
+		}
+		if !cmp.Equal(got, want) {
+			t.Errorf(cmp.Diff(want, got))
+		}
+	})
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+var (
+	relationalOps = []token.Token{token.LSS, token.LEQ, token.GTR, token.GEQ, token.EQL, token.NEQ}
+	equalityOps   = []token.Token{token.EQL, token.NEQ}
+	arithmeticOps = []token.Token{token.ADD, token.SUB, token.MUL, token.QUO, token.REM}
+)
+
+// findRelationalReplacements replaces each relational operator with all the
+// other ones. The equality checks of operands which are not known to be
+// ordered, such as pointers and booleans, are only swapped with each other.
+func findRelationalReplacements(n ast.Node, c *cursor) []NodeMutation {
+	b, ok := n.(*ast.BinaryExpr)
+	if !ok || !isOneOf(b.Op, relationalOps) {
+		return nil
+	}
+	ops := relationalOps
+	if isOneOf(b.Op, equalityOps) && !isOrdered(typeOf(c.info, b.X)) {
+		ops = equalityOps
+	}
+
+	return replaceOperator(b, ops)
+}
+
+// findArithmeticReplacements replaces each arithmetic operator with all the
+// other ones. The string concatenations are left alone, the remainder is
+// only used on integers and the divisions by a constant zero are skipped.
+func findArithmeticReplacements(n ast.Node, c *cursor) []NodeMutation {
+	b, ok := n.(*ast.BinaryExpr)
+	if !ok || !isOneOf(b.Op, arithmeticOps) {
+		return nil
+	}
+	t := typeOf(c.info, b.X)
+	if isString(t) {
+		return nil
+	}
+
+	var ops []token.Token
+	for _, op := range arithmeticOps {
+		if op == token.REM && t != nil && !isInteger(t) {
+			continue
+		}
+		if (op == token.QUO || op == token.REM) && isConstZero(c.info, b.Y) {
+			continue
+		}
+		ops = append(ops, op)
+	}
+
+	return replaceOperator(b, ops)
+}
+
+// findLogicalReplacements replaces the logical operators with the other
+// one, and the whole expression with each of its operands, true and false.
+func findLogicalReplacements(n ast.Node, c *cursor) []NodeMutation {
+	b, ok := n.(*ast.BinaryExpr)
+	if !ok || (b.Op != token.LAND && b.Op != token.LOR) {
+		return nil
+	}
+	parent := c.parent()
+	mutations := replaceOperator(b, []token.Token{token.LAND, token.LOR})
+	for _, repl := range []ast.Expr{b.X, b.Y, ast.NewIdent("true"), ast.NewIdent("false")} {
+		repl := repl
+		mutations = append(mutations, NodeMutation{
+			Pos:  b.OpPos,
+			Desc: types.ExprString(repl),
+			Mutate: func() func() {
+				return replaceNode(parent, b, repl)
+			},
+		})
+	}
+
+	return mutations
+}
+
+// replaceOperator returns the mutations setting the operator of b to each
+// of the ops, but its own and the ones already produced by the enabled
+// token mutator types.
+func replaceOperator(b *ast.BinaryExpr, ops []token.Token) []NodeMutation {
+	base := make(map[token.Token]bool)
+	for _, mt := range enabledTokenTypes(b.Op) {
+		base[tokenMutations[mt][b.Op]] = true
+	}
+
+	var mutations []NodeMutation
+	for _, op := range ops {
+		if op == b.Op || base[op] {
+			continue
+		}
+		op := op
+		mutations = append(mutations, NodeMutation{
+			Pos:  b.OpPos,
+			Desc: op.String(),
+			Mutate: func() func() {
+				orig := b.Op
+				b.Op = op
+
+				return func() {
+					b.Op = orig
+				}
+			},
+		})
+	}
+
+	return mutations
+}
+
+func isOneOf(tok token.Token, list []token.Token) bool {
+	for _, t := range list {
+		if t == tok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isOrdered tells if the values of t can be compared with the ordering
+// operators.
+func isOrdered(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	u, ok := t.Underlying().(*types.Basic)
+
+	return ok && u.Info()&types.IsOrdered != 0
+}
+
+func isString(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	u, ok := t.Underlying().(*types.Basic)
+
+	return ok && u.Info()&types.IsString != 0
+}
+
+func isInteger(t types.Type) bool {
+	u, ok := t.Underlying().(*types.Basic)
+
+	return ok && u.Info()&types.IsInteger != 0
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// findReturnValues replaces the results of a return statement with the
+// zero values of the result types of the enclosing function.
+//
+// Bare returns are skipped, as well as the return statements that already
+// return only zero values.
+func findReturnValues(n ast.Node, c *cursor) []NodeMutation {
+	ret, ok := n.(*ast.ReturnStmt)
+	if !ok || len(ret.Results) == 0 {
+		return nil
+	}
+	fType := c.enclosingFunc()
+	if fType == nil {
+		return nil
+	}
+	zeros, ok := zeroResults(c.info, fType)
+	if !ok || sameExprs(zeros, ret.Results) {
+		return nil
+	}
+
+	return []NodeMutation{{
+		Pos: ret.Pos(),
+		Mutate: func() func() {
+			orig := ret.Results
+			ret.Results = zeros
+
+			return func() {
+				ret.Results = orig
+			}
+		},
+	}}
+}
+
+// zeroResults returns the zero values of the results of the function type.
+// It returns false if the zero value of any of them cannot be determined.
+func zeroResults(info *types.Info, fType *ast.FuncType) ([]ast.Expr, bool) {
+	if fType.Results == nil {
+		return nil, true
+	}
+	var zeros []ast.Expr
+	for _, field := range fType.Results.List {
+		t := typeOf(info, field.Type)
+		if t == nil {
+			return nil, false
+		}
+		zero, ok := zeroValue(t, field.Type)
+		if !ok {
+			return nil, false
+		}
+		// Unnamed results have a single entry.
+		for i := 0; i < max(len(field.Names), 1); i++ {
+			zeros = append(zeros, zero)
+		}
+	}
+
+	return zeros, true
+}
+
+func sameExprs(x, y []ast.Expr) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if types.ExprString(x[i]) != types.ExprString(y[i]) {
+			return false
+		}
+	}
+
+	return true
+}
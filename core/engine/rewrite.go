@@ -0,0 +1,101 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"reflect"
+)
+
+// replaceNode swaps the child old of parent with repl and returns the
+// function that puts old back in its place.
+//
+// The child is looked up in the fields of parent, both in the single node
+// fields and in the node lists. It panics if old is not a direct child of
+// parent, because it means the mutation has been built on the wrong node.
+func replaceNode(parent, old, repl ast.Node) func() {
+	if f, ok := childValue(parent, old); ok {
+		return setValue(f, repl)
+	}
+
+	panic("node to replace is not a child of the given parent")
+}
+
+// canReplace tells if old is a direct child of parent, held in a field
+// that can take repl. For example, the selector of an ast.SelectorExpr can
+// only be replaced with an *ast.Ident.
+func canReplace(parent, old, repl ast.Node) bool {
+	f, ok := childValue(parent, old)
+
+	return ok && reflect.TypeOf(repl).AssignableTo(f.Type())
+}
+
+func childValue(parent, old ast.Node) (reflect.Value, bool) {
+	v := reflect.ValueOf(parent).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		switch f.Kind() {
+		case reflect.Interface, reflect.Pointer:
+			if !f.IsNil() && f.Interface() == old {
+				return f, true
+			}
+		case reflect.Slice:
+			for j := 0; j < f.Len(); j++ {
+				e := f.Index(j)
+				if e.Kind() == reflect.Interface || e.Kind() == reflect.Pointer {
+					if !e.IsNil() && e.Interface() == old {
+						return e, true
+					}
+				}
+			}
+		default:
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+func setValue(v reflect.Value, repl ast.Node) func() {
+	orig := reflect.New(v.Type()).Elem()
+	orig.Set(v)
+	v.Set(reflect.ValueOf(repl))
+
+	return func() {
+		v.Set(orig)
+	}
+}
+
+// removeStmt replaces the statement with an empty one, which is not
+// printed, and returns the function that puts it back.
+func removeStmt(parent ast.Node, stmt ast.Stmt) func() {
+	return replaceNode(parent, stmt, &ast.EmptyStmt{Semicolon: stmt.Pos(), Implicit: true})
+}
+
+// insertStmt inserts the statement in the block at the given index, and
+// returns the function that removes it.
+func insertStmt(block *ast.BlockStmt, i int, stmt ast.Stmt) func() {
+	orig := block.List
+	list := make([]ast.Stmt, 0, len(orig)+1)
+	list = append(list, orig[:i]...)
+	list = append(list, stmt)
+	list = append(list, orig[i:]...)
+	block.List = list
+
+	return func() {
+		block.List = orig
+	}
+}
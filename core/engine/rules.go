@@ -0,0 +1,252 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"unicode"
+
+	"github.com/go-maxhub/gremlins/core/configuration"
+	"github.com/go-maxhub/gremlins/core/log"
+	"github.com/go-maxhub/gremlins/core/mutator"
+)
+
+// rule is a user-defined mutation, rewriting the expressions matching the
+// pattern into the replacement. As in gofmt -r, the identifiers made of a
+// single lowercase letter are wildcards, which match any expression and
+// are carried over to the replacement.
+type rule struct {
+	mt          mutator.Type
+	pattern     ast.Expr
+	replacement ast.Expr
+}
+
+// newRules parses the enabled rules of the configuration, registering a
+// dynamic mutator.Type for each of them. The invalid rules are reported
+// and skipped.
+func newRules() []rule {
+	var rules []rule
+	for _, r := range configuration.GetRules() {
+		if !r.Enabled {
+			continue
+		}
+		parsed, err := parseRule(r)
+		if err != nil {
+			log.Errorf("skipping rule %q: %s\n", r.Name, err)
+
+			continue
+		}
+		rules = append(rules, parsed)
+	}
+
+	return rules
+}
+
+func parseRule(r configuration.Rule) (rule, error) {
+	pattern, err := parser.ParseExpr(r.Pattern)
+	if err != nil {
+		return rule{}, fmt.Errorf("invalid pattern: %w", err)
+	}
+	replacement, err := parser.ParseExpr(r.Replacement)
+	if err != nil {
+		return rule{}, fmt.Errorf("invalid replacement: %w", err)
+	}
+	mt, err := mutator.Register(r.Name)
+	if err != nil {
+		return rule{}, err
+	}
+
+	return rule{mt: mt, pattern: pattern, replacement: replacement}, nil
+}
+
+// find rewrites the expression n if it matches the pattern of the rule.
+func (r rule) find(n ast.Node, c *cursor) []NodeMutation {
+	e, ok := n.(ast.Expr)
+	if !ok || c.parent() == nil {
+		return nil
+	}
+	m := make(map[string]reflect.Value)
+	if !match(m, reflect.ValueOf(r.pattern), reflect.ValueOf(e)) {
+		return nil
+	}
+	repl, _ := subst(m, reflect.ValueOf(r.replacement), reflect.ValueOf(e.Pos())).Interface().(ast.Expr)
+	parent := c.parent()
+	if repl == nil || !canReplace(parent, e, repl) {
+		return nil
+	}
+
+	return []NodeMutation{{
+		Pos: e.Pos(),
+		Mutate: func() func() {
+			return replaceNode(parent, e, repl)
+		},
+	}}
+}
+
+var (
+	identType     = reflect.TypeOf((*ast.Ident)(nil))
+	objectPtrType = reflect.TypeOf((*ast.Object)(nil))
+	positionType  = reflect.TypeOf(token.NoPos)
+	callExprType  = reflect.TypeOf((*ast.CallExpr)(nil))
+)
+
+func isWildcard(s string) bool {
+	return len(s) == 1 && unicode.IsLower(rune(s[0]))
+}
+
+// match reports whether pattern matches val, recording the wildcard
+// matches in m. A wildcard appearing more than once in the pattern must
+// match the same expression each time. If m is nil, the wildcards are
+// compared as any other identifier.
+func match(m map[string]reflect.Value, pattern, val reflect.Value) bool {
+	if m != nil && pattern.IsValid() && pattern.Type() == identType {
+		name := pattern.Interface().(*ast.Ident).Name
+		if isWildcard(name) && val.IsValid() {
+			if _, ok := val.Interface().(ast.Expr); ok && !val.IsNil() {
+				if old, ok := m[name]; ok {
+					return match(nil, old, val)
+				}
+				m[name] = val
+
+				return true
+			}
+		}
+	}
+
+	if !pattern.IsValid() || !val.IsValid() {
+		return !pattern.IsValid() && !val.IsValid()
+	}
+	if pattern.Type() != val.Type() {
+		return false
+	}
+
+	switch pattern.Type() {
+	case identType:
+		p := pattern.Interface().(*ast.Ident)
+		v := val.Interface().(*ast.Ident)
+
+		return p == nil && v == nil || p != nil && v != nil && p.Name == v.Name
+	case objectPtrType, positionType:
+		return true
+	case callExprType:
+		// f(x) and f(x...) only differ in the position of the ellipsis.
+		p := pattern.Interface().(*ast.CallExpr)
+		v := val.Interface().(*ast.CallExpr)
+		if p.Ellipsis.IsValid() != v.Ellipsis.IsValid() {
+			return false
+		}
+	}
+
+	p := reflect.Indirect(pattern)
+	v := reflect.Indirect(val)
+	if !p.IsValid() || !v.IsValid() {
+		return !p.IsValid() && !v.IsValid()
+	}
+
+	switch p.Kind() {
+	case reflect.Slice:
+		if p.Len() != v.Len() {
+			return false
+		}
+		for i := 0; i < p.Len(); i++ {
+			if !match(m, p.Index(i), v.Index(i)) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Struct:
+		for i := 0; i < p.NumField(); i++ {
+			if !match(m, p.Field(i), v.Field(i)) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Interface:
+		return match(m, p.Elem(), v.Elem())
+	default:
+	}
+
+	return p.Interface() == v.Interface()
+}
+
+// subst returns a copy of pattern with the wildcards replaced by their
+// matches in m. The valid positions of the copy are set to pos, so that
+// the printer keeps the replacement where the original expression was.
+func subst(m map[string]reflect.Value, pattern, pos reflect.Value) reflect.Value {
+	if !pattern.IsValid() {
+		return reflect.Value{}
+	}
+
+	if pattern.Type() == identType {
+		if old, ok := m[pattern.Interface().(*ast.Ident).Name]; ok {
+			return old
+		}
+	}
+	if pattern.Type() == objectPtrType {
+		return reflect.Zero(objectPtrType)
+	}
+	if pattern.Type() == positionType {
+		if old := pattern.Interface().(token.Pos); !old.IsValid() {
+			return pattern
+		}
+
+		return pos
+	}
+
+	switch p := pattern; p.Kind() {
+	case reflect.Slice:
+		if p.IsNil() {
+			return reflect.Zero(p.Type())
+		}
+		v := reflect.MakeSlice(p.Type(), p.Len(), p.Len())
+		for i := 0; i < p.Len(); i++ {
+			v.Index(i).Set(subst(m, p.Index(i), pos))
+		}
+
+		return v
+	case reflect.Struct:
+		v := reflect.New(p.Type()).Elem()
+		for i := 0; i < p.NumField(); i++ {
+			v.Field(i).Set(subst(m, p.Field(i), pos))
+		}
+
+		return v
+	case reflect.Pointer:
+		v := reflect.New(p.Type()).Elem()
+		if elem := p.Elem(); elem.IsValid() {
+			v.Set(subst(m, elem, pos).Addr())
+		}
+
+		return v
+	case reflect.Interface:
+		v := reflect.New(p.Type()).Elem()
+		if elem := p.Elem(); elem.IsValid() {
+			v.Set(subst(m, elem, pos))
+		}
+
+		return v
+	default:
+	}
+
+	return pattern
+}
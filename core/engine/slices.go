@@ -0,0 +1,146 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+)
+
+// findSliceBoundaries shifts the low and high bounds of the slice
+// expressions by one, in both directions.
+func findSliceBoundaries(n ast.Node, c *cursor) []NodeMutation {
+	s, ok := n.(*ast.SliceExpr)
+	if !ok {
+		return nil
+	}
+
+	var mutations []NodeMutation
+	for _, bound := range []*ast.Expr{&s.Low, &s.High} {
+		if *bound == nil {
+			continue
+		}
+		mutations = append(mutations, shiftByOne(c.info, bound)...)
+	}
+
+	return mutations
+}
+
+// findSliceBoundRemovals drops, one at a time, the low and high bounds of
+// the slice expressions. The high bound of full slice expressions is
+// mandatory and is left untouched.
+func findSliceBoundRemovals(n ast.Node, _ *cursor) []NodeMutation {
+	s, ok := n.(*ast.SliceExpr)
+	if !ok {
+		return nil
+	}
+
+	var mutations []NodeMutation
+	for _, bound := range []*ast.Expr{&s.Low, &s.High} {
+		if *bound == nil || (bound == &s.High && s.Slice3) {
+			continue
+		}
+		bound := bound
+		mutations = append(mutations, NodeMutation{
+			Pos: (*bound).Pos(),
+			Mutate: func() func() {
+				orig := *bound
+				*bound = nil
+
+				return func() {
+					*bound = orig
+				}
+			},
+		})
+	}
+
+	return mutations
+}
+
+// findIndexShifts shifts the index of the index expressions on slices,
+// arrays and strings by one, in both directions. Maps are left alone, as
+// well as the expressions whose operand type is unknown and the
+// instantiations of generic types, such as `Vec[int]`.
+func findIndexShifts(n ast.Node, c *cursor) []NodeMutation {
+	ix, ok := n.(*ast.IndexExpr)
+	if !ok || isType(c.info, ix.X) || isType(c.info, ix.Index) || !isIndexable(typeOf(c.info, ix.X)) {
+		return nil
+	}
+
+	return shiftByOne(c.info, &ix.Index)
+}
+
+// shiftByOne returns the mutations turning the expression e into e+1 and
+// e-1. Constant expressions are not shifted below zero.
+func shiftByOne(info *types.Info, e *ast.Expr) []NodeMutation {
+	var mutations []NodeMutation
+	for _, op := range []token.Token{token.ADD, token.SUB} {
+		if op == token.SUB && isConstZero(info, *e) {
+			continue
+		}
+		op := op
+		mutations = append(mutations, NodeMutation{
+			Pos: (*e).Pos(),
+			Mutate: func() func() {
+				orig := *e
+				*e = &ast.BinaryExpr{X: orig, Op: op, Y: &ast.BasicLit{Kind: token.INT, Value: "1"}}
+
+				return func() {
+					*e = orig
+				}
+			},
+		})
+	}
+
+	return mutations
+}
+
+func isConstZero(info *types.Info, e ast.Expr) bool {
+	if lit, ok := unparen(e).(*ast.BasicLit); ok && lit.Kind == token.INT {
+		v := constant.MakeFromLiteral(lit.Value, lit.Kind, 0)
+
+		return constant.Sign(v) == 0
+	}
+	if info == nil {
+		return false
+	}
+	tv, ok := info.Types[e]
+
+	return ok && tv.Value != nil && constant.Sign(tv.Value) == 0
+}
+
+// isIndexable tells if t is a slice, an array, a pointer to an array or a
+// string type.
+func isIndexable(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Slice, *types.Array:
+		return true
+	case *types.Pointer:
+		_, ok := u.Elem().Underlying().(*types.Array)
+
+		return ok
+	case *types.Basic:
+		return u.Info()&types.IsString != 0
+	}
+
+	return false
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/go-maxhub/gremlins/core/configuration"
+	"github.com/go-maxhub/gremlins/core/mutator"
+)
+
+// specificRemovals are the mutator.Type removing some kinds of statements
+// only, with the nodeFinder discovering them. The statements they remove
+// are left to them when they are enabled, so that the same mutant isn't
+// found twice.
+var specificRemovals = map[mutator.Type]nodeFinder{
+	mutator.RemoveClose:    findRemoveCloses,
+	mutator.RemoveDelete:   findRemoveDeletes,
+	mutator.RemoveMapWrite: findRemoveMapWrites,
+	mutator.RemovePanic:    findRemovePanics,
+}
+
+// findStatementRemovals removes expression statements, such as function
+// calls, and assignments.
+//
+// Only the statements of a statement list are removed, so that the init
+// and post statements of if, for and switch are left untouched. Short
+// variable declarations are skipped as well, because removing them would
+// leave the declared variables undefined, and so are the assignments to
+// the blank identifier only, which mark the variables as used. The
+// statements removed by the enabled specificRemovals are skipped too.
+func findStatementRemovals(n ast.Node, c *cursor) []NodeMutation {
+	switch s := n.(type) {
+	case *ast.ExprStmt:
+	case *ast.AssignStmt:
+		if s.Tok == token.DEFINE || isBlankAssign(s) {
+			return nil
+		}
+	default:
+		return nil
+	}
+	parent := c.parent()
+	if !isStmtList(parent) {
+		return nil
+	}
+	for mt, find := range specificRemovals {
+		if configuration.Get[bool](configuration.MutantTypeEnabledKey(mt)) && len(find(n, c)) > 0 {
+			return nil
+		}
+	}
+	stmt := n.(ast.Stmt)
+
+	return []NodeMutation{{
+		Pos: stmt.Pos(),
+		Mutate: func() func() {
+			return removeStmt(parent, stmt)
+		},
+	}}
+}
+
+func isBlankAssign(s *ast.AssignStmt) bool {
+	for _, lhs := range s.Lhs {
+		if id, ok := lhs.(*ast.Ident); !ok || id.Name != "_" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isStmtList(n ast.Node) bool {
+	switch n.(type) {
+	case *ast.BlockStmt, *ast.CaseClause, *ast.CommClause:
+		return true
+	default:
+		return false
+	}
+}
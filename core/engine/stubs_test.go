@@ -135,6 +135,7 @@ type mutantStub struct {
 	mutType        mutator.Type
 	applyCalled    bool
 	rollbackCalled bool
+	race           bool
 
 	hasApplyError bool
 }
@@ -155,6 +156,14 @@ func (m *mutantStub) SetStatus(s mutator.Status) {
 	m.status = s
 }
 
+func (m *mutantStub) SetRace(race bool) {
+	m.race = race
+}
+
+func (m *mutantStub) Race() bool {
+	return m.race
+}
+
 func (m *mutantStub) Position() token.Position {
 	return m.position
 }
@@ -0,0 +1,220 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// lockPair is a call locking a sync.Mutex or a sync.RWMutex, followed in
+// the same statement list by the matching unlock, deferred or not.
+type lockPair struct {
+	lock, unlock       ast.Stmt
+	lockSel, unlockSel *ast.Ident
+}
+
+// findRemoveLocks removes the Lock/Unlock and RLock/RUnlock pairs of the
+// sync mutexes, leaving the critical section unprotected.
+func findRemoveLocks(n ast.Node, c *cursor) []NodeMutation {
+	var mutations []NodeMutation
+	for _, p := range lockPairs(n, c.info, "Lock", "RLock") {
+		p := p
+		mutations = append(mutations, NodeMutation{
+			Pos: p.lock.Pos(),
+			Mutate: func() func() {
+				restoreLock := removeStmt(n, p.lock)
+				restoreUnlock := removeStmt(n, p.unlock)
+
+				return func() {
+					restoreUnlock()
+					restoreLock()
+				}
+			},
+		})
+	}
+
+	return mutations
+}
+
+// findRLockToLocks turns the RLock/RUnlock pairs of sync.RWMutex into
+// Lock/Unlock, so that readers exclude each other.
+func findRLockToLocks(n ast.Node, c *cursor) []NodeMutation {
+	var mutations []NodeMutation
+	for _, p := range lockPairs(n, c.info, "RLock") {
+		p := p
+		mutations = append(mutations, NodeMutation{
+			Pos: p.lock.Pos(),
+			Mutate: func() func() {
+				p.lockSel.Name, p.unlockSel.Name = "Lock", "Unlock"
+
+				return func() {
+					p.lockSel.Name, p.unlockSel.Name = "RLock", "RUnlock"
+				}
+			},
+		})
+	}
+
+	return mutations
+}
+
+// findWaitGroupAdds decrements the delta passed to sync.WaitGroup.Add.
+func findWaitGroupAdds(n ast.Node, c *cursor) []NodeMutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || !isSyncMethod(c.info, call, "WaitGroup", "Add") {
+		return nil
+	}
+	arg := &call.Args[0]
+
+	return []NodeMutation{{
+		Pos: (*arg).Pos(),
+		Mutate: func() func() {
+			orig := *arg
+			*arg = &ast.BinaryExpr{X: orig, Op: token.SUB, Y: &ast.BasicLit{Kind: token.INT, Value: "1"}}
+
+			return func() {
+				*arg = orig
+			}
+		},
+	}}
+}
+
+// findOnceDos replaces once.Do(f) with a direct call to f, which then runs
+// each time.
+func findOnceDos(n ast.Node, c *cursor) []NodeMutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || !isSyncMethod(c.info, call, "Once", "Do") {
+		return nil
+	}
+	parent := c.parent()
+
+	return []NodeMutation{{
+		Pos: call.Pos(),
+		Mutate: func() func() {
+			return replaceNode(parent, call, &ast.CallExpr{Fun: call.Args[0]})
+		},
+	}}
+}
+
+// unlockMethods maps the lock methods of the sync mutexes to the methods
+// releasing them.
+var unlockMethods = map[string]string{
+	"Lock":  "Unlock",
+	"RLock": "RUnlock",
+}
+
+// lockPairs returns the lockPair found in the statement list n, for the
+// given lock methods.
+func lockPairs(n ast.Node, info *types.Info, locks ...string) []lockPair {
+	var list []ast.Stmt
+	switch s := n.(type) {
+	case *ast.BlockStmt:
+		list = s.List
+	case *ast.CaseClause:
+		list = s.Body
+	case *ast.CommClause:
+		list = s.Body
+	default:
+		return nil
+	}
+
+	var pairs []lockPair
+	for i, stmt := range list {
+		es, ok := stmt.(*ast.ExprStmt)
+		if !ok {
+			continue
+		}
+		call, ok := es.X.(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+		for _, lock := range locks {
+			if !isSyncMethod(info, call, "Mutex", lock) && !isSyncMethod(info, call, "RWMutex", lock) {
+				continue
+			}
+			if p, ok := matchUnlock(info, list[i+1:], call, unlockMethods[lock]); ok {
+				p.lock = stmt
+				pairs = append(pairs, p)
+			}
+		}
+	}
+
+	return pairs
+}
+
+// matchUnlock looks for the first call to the unlock method on the same
+// receiver of lock in the list, either as an expression or a defer
+// statement.
+func matchUnlock(info *types.Info, list []ast.Stmt, lock *ast.CallExpr, unlock string) (lockPair, bool) {
+	lockSel, ok := unparen(lock.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return lockPair{}, false
+	}
+	recv := types.ExprString(lockSel.X)
+	for _, stmt := range list {
+		var call *ast.CallExpr
+		switch s := stmt.(type) {
+		case *ast.ExprStmt:
+			call, _ = s.X.(*ast.CallExpr)
+		case *ast.DeferStmt:
+			call = s.Call
+		}
+		if call == nil {
+			continue
+		}
+		sel, ok := unparen(call.Fun).(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != unlock || types.ExprString(sel.X) != recv {
+			continue
+		}
+		if !isSyncMethod(info, call, "Mutex", unlock) && !isSyncMethod(info, call, "RWMutex", unlock) {
+			continue
+		}
+
+		return lockPair{unlock: stmt, lockSel: lockSel.Sel, unlockSel: sel.Sel}, true
+	}
+
+	return lockPair{}, false
+}
+
+// isSyncMethod tells if the expression calls one of the named methods of
+// the given type of the sync package.
+func isSyncMethod(info *types.Info, call *ast.CallExpr, typeName string, names ...string) bool {
+	f := calledFunc(info, call)
+	if f == nil || f.Pkg() == nil || f.Pkg().Path() != "sync" {
+		return false
+	}
+	sig, ok := f.Type().(*types.Signature)
+	if !ok || sig.Recv() == nil {
+		return false
+	}
+	recv := sig.Recv().Type()
+	if p, ok := recv.(*types.Pointer); ok {
+		recv = p.Elem()
+	}
+	named, ok := recv.(*types.Named)
+	if !ok || named.Obj().Name() != typeName {
+		return false
+	}
+	for _, name := range names {
+		if f.Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
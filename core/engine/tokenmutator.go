@@ -39,15 +39,15 @@ import (
 // Keeping a lock per file instead of a lock per TokenMutator allows to apply
 // mutations on different files in parallel.
 type TokenMutator struct {
-	pkg         string
-	fs          *token.FileSet
-	file        *ast.File
-	tokenNode   *NodeToken
-	workDir     string
-	origFile    []byte
-	status      mutator.Status
-	mutantType  mutator.Type
-	actualToken token.Token
+	pkg        string
+	fs         *token.FileSet
+	file       *ast.File
+	tokenNode  *NodeToken
+	workDir    string
+	origFile   []byte
+	status     mutator.Status
+	mutantType mutator.Type
+	race       bool
 }
 
 // NewTokenMutant initialises a TokenMutator.
@@ -80,6 +80,16 @@ func (m *TokenMutator) SetStatus(s mutator.Status) {
 	m.status = s
 }
 
+// SetRace implements the mutator.RaceRecorder interface.
+func (m *TokenMutator) SetRace(race bool) {
+	m.race = race
+}
+
+// Race implements the mutator.RaceRecorder interface.
+func (m *TokenMutator) Race() bool {
+	return m.race
+}
+
 // Position returns the token.Position where the TokenMutator resides.
 func (m *TokenMutator) Position() token.Position {
 	return m.fs.Position(m.tokenNode.TokPos)
@@ -115,22 +125,32 @@ func (m *TokenMutator) Apply() error {
 		return err
 	}
 
-	m.actualToken = m.tokenNode.Tok()
-	m.tokenNode.SetTok(tokenMutations[m.Type()][m.tokenNode.Tok()])
+	// Rollback here to facilitate the atomicity of the operation.
+	restore := m.mutate()
+	defer restore()
 
-	if err = m.writeMutatedFile(filename); err != nil {
-		return err
-	}
+	return writeMutatedFile(filename, m.fs, m.file)
+}
 
-	// Rollback here to facilitate the atomicity of the operation.
-	m.tokenNode.SetTok(m.actualToken)
+// mutate sets the token from the tokenMutations table and returns the
+// function that puts back the original one.
+func (m *TokenMutator) mutate() func() {
+	actual := m.tokenNode.Tok()
+	m.tokenNode.SetTok(tokenMutations[m.Type()][actual])
 
-	return nil
+	return func() {
+		m.tokenNode.SetTok(actual)
+	}
+}
+
+// source returns the file the TokenMutator operates on.
+func (m *TokenMutator) source() (*token.FileSet, *ast.File) {
+	return m.fs, m.file
 }
 
-func (m *TokenMutator) writeMutatedFile(filename string) error {
+func writeMutatedFile(filename string, set *token.FileSet, file *ast.File) error {
 	w := &bytes.Buffer{}
-	err := printer.Fprint(w, m.fs, m.file)
+	err := printer.Fprint(w, set, file)
 	if err != nil {
 		return err
 	}
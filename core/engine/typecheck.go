@@ -0,0 +1,240 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package engine
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sync"
+)
+
+// dirImporter is a types.ImporterFrom that resolves the imports relative
+// to the root of the analysed module.
+//
+// The files are parsed with a path relative to the fs.FS of the Engine, so
+// the directory the type checker passes along must be anchored to it.
+type dirImporter struct {
+	types.ImporterFrom
+	root string
+	mu   sync.Mutex
+}
+
+// importers holds the dirImporter of each module root, so that the
+// packages imported from source, which take long to type check, are
+// shared by the Engines analysing the same module.
+var importers struct {
+	sync.Mutex
+	byRoot map[string]*dirImporter
+}
+
+// newImporter returns the dirImporter of the module root, creating it on
+// first use.
+func newImporter(root string) *dirImporter {
+	if abs, err := filepath.Abs(root); err == nil {
+		root = abs
+	}
+	importers.Lock()
+	defer importers.Unlock()
+	if imp, ok := importers.byRoot[root]; ok {
+		return imp
+	}
+	if importers.byRoot == nil {
+		importers.byRoot = make(map[string]*dirImporter)
+	}
+	from, _ := importer.ForCompiler(token.NewFileSet(), "source", nil).(types.ImporterFrom)
+	imp := &dirImporter{ImporterFrom: from, root: root}
+	importers.byRoot[root] = imp
+
+	return imp
+}
+
+// ImportFrom implements the types.ImporterFrom interface. The source
+// importer isn't safe for concurrent use, so the imports are serialised.
+func (i *dirImporter) ImportFrom(path, dir string, mode types.ImportMode) (*types.Package, error) {
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(i.root, dir)
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.ImporterFrom.ImportFrom(path, dir, mode)
+}
+
+// typeCheck gathers the type information of the files of a package.
+//
+// Type checking errors are ignored: the source code may not be complete,
+// for example because it depends on build tags or on generated code, and
+// the mutators relying on the types.Info must cope with missing entries.
+func typeCheck(imp types.Importer, set *token.FileSet, files []*ast.File) *types.Info {
+	info := &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+	}
+	if len(files) == 0 {
+		return info
+	}
+	conf := types.Config{
+		Importer: imp,
+		Error:    func(error) {},
+	}
+	_, _ = conf.Check(files[0].Name.Name, set, files, info)
+
+	return info
+}
+
+// typeOf returns the types.Type of the expression. If the type checker
+// couldn't resolve it, the predeclared types are still recognised by name.
+func typeOf(info *types.Info, expr ast.Expr) types.Type {
+	if info != nil {
+		if t := info.TypeOf(expr); t != nil && t != types.Typ[types.Invalid] {
+			return t
+		}
+	}
+	if id, ok := expr.(*ast.Ident); ok {
+		if obj, ok := types.Universe.Lookup(id.Name).(*types.TypeName); ok {
+			return obj.Type()
+		}
+	}
+
+	return nil
+}
+
+// isType tells if the expression denotes a type, such as the type
+// arguments of an instantiated generic type.
+func isType(info *types.Info, expr ast.Expr) bool {
+	if info == nil {
+		return false
+	}
+	tv, ok := info.Types[expr]
+
+	return ok && tv.IsType()
+}
+
+// isError tells if t is the predeclared error type.
+func isError(t types.Type) bool {
+	return t != nil && types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+// calledFunc returns the function or method called by the expression, as
+// resolved by the type checker. It returns nil for builtins, conversions
+// and the calls to function values.
+func calledFunc(info *types.Info, call *ast.CallExpr) *types.Func {
+	var id *ast.Ident
+	switch fun := unparen(call.Fun).(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	default:
+		return nil
+	}
+	if info == nil {
+		return nil
+	}
+	f, _ := info.Uses[id].(*types.Func)
+
+	return f
+}
+
+// isBuiltin tells if the expression calls the named builtin function. If
+// the type checker couldn't resolve the call, the name alone is used.
+func isBuiltin(info *types.Info, call *ast.CallExpr, name string) bool {
+	id, ok := unparen(call.Fun).(*ast.Ident)
+	if !ok || id.Name != name {
+		return false
+	}
+	if info == nil {
+		return true
+	}
+	obj, ok := info.Uses[id]
+	if !ok {
+		return true
+	}
+	_, ok = obj.(*types.Builtin)
+
+	return ok
+}
+
+// isPkgFunc tells if the expression calls one of the named functions of
+// the package with the given import path.
+func isPkgFunc(info *types.Info, call *ast.CallExpr, pkgPath string, names ...string) bool {
+	f := calledFunc(info, call)
+	if f == nil || f.Pkg() == nil || f.Pkg().Path() != pkgPath {
+		return false
+	}
+	if sig, ok := f.Type().(*types.Signature); ok && sig.Recv() != nil {
+		return false
+	}
+	for _, n := range names {
+		if f.Name() == n {
+			return true
+		}
+	}
+
+	return false
+}
+
+func unparen(e ast.Expr) ast.Expr {
+	for {
+		p, ok := e.(*ast.ParenExpr)
+		if !ok {
+			return e
+		}
+		e = p.X
+	}
+}
+
+// zeroValue returns the expression of the zero value of t. The expr is the
+// expression of the type in the source code, used for composite types.
+// It returns false if the zero value cannot be expressed.
+func zeroValue(t types.Type, expr ast.Expr) (ast.Expr, bool) {
+	if _, ok := t.(*types.TypeParam); ok {
+		// *new(T)
+		return &ast.StarExpr{X: &ast.CallExpr{Fun: ast.NewIdent("new"), Args: []ast.Expr{typeExpr(expr)}}}, true
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return ast.NewIdent("false"), true
+		case u.Info()&types.IsString != 0:
+			return &ast.BasicLit{Kind: token.STRING, Value: `""`}, true
+		case u.Info()&types.IsNumeric != 0:
+			return &ast.BasicLit{Kind: token.INT, Value: "0"}, true
+		case u.Kind() == types.UnsafePointer:
+			return ast.NewIdent("nil"), true
+		}
+	case *types.Pointer, *types.Slice, *types.Map, *types.Chan, *types.Signature, *types.Interface:
+		return ast.NewIdent("nil"), true
+	case *types.Struct, *types.Array:
+		return &ast.CompositeLit{Type: typeExpr(expr)}, true
+	}
+
+	return nil, false
+}
+
+// typeExpr returns a copy of the type expression detached from the
+// positions of the original source code, so that it can be safely printed
+// in a different place.
+func typeExpr(expr ast.Expr) ast.Expr {
+	return ast.NewIdent(types.ExprString(expr))
+}
@@ -16,7 +16,12 @@
 
 package mutator
 
-import "go/token"
+import (
+	"fmt"
+	"go/token"
+	"strings"
+	"sync"
+)
 
 // Status represents the status of a given TokenMutant.
 //
@@ -83,6 +88,58 @@ const (
 	InvertLoopCtrl
 	InvertNegatives
 	RemoveSelfAssignments
+	StatementRemoval
+	ReturnValues
+	ErrorReturnNil
+	ErrorGuardRemoval
+	ErrorCheckNegation
+	ErrorUnwrap
+	NumericLiteral
+	StringLiteral
+	InvertBooleans
+	ForceConditions
+	RemoveNot
+	RemoveCase
+	EmptyCase
+	RemoveDefault
+	SliceBoundary
+	SliceBoundRemoval
+	IndexShift
+	InlineGoroutine
+	RemoveDefer
+	RemoveLock
+	RLockToLock
+	WaitGroupAdd
+	OnceDo
+	ChannelBuffer
+	RemoveClose
+	SelectDefault
+	ContextBackground
+	ContextWrapRemoval
+	ArgumentSwap
+	RemoveAppend
+	RemoveDelete
+	RemoveMapWrite
+	FieldRemoval
+	APISwap
+	RelationalReplacement
+	ArithmeticReplacement
+	LogicalReplacement
+	BuiltinLength
+	BuiltinMinMax
+	BuiltinCopy
+	RemoveRecover
+	RemovePanic
+	LoopSkip
+	LoopOnce
+
+	// HigherOrder is the Type of the mutants combining other mutants. It is
+	// not part of Types, since it cannot be enabled on its own.
+	HigherOrder
+	// Extreme is the Type of the mutants removing the body of a whole
+	// function. It is not part of Types, since it is only used in the
+	// extreme mode.
+	Extreme
 )
 
 // Types allows to iterate over Type.
@@ -98,9 +155,126 @@ var Types = []Type{
 	InvertLoopCtrl,
 	InvertNegatives,
 	RemoveSelfAssignments,
+	StatementRemoval,
+	ReturnValues,
+	ErrorReturnNil,
+	ErrorGuardRemoval,
+	ErrorCheckNegation,
+	ErrorUnwrap,
+	NumericLiteral,
+	StringLiteral,
+	InvertBooleans,
+	ForceConditions,
+	RemoveNot,
+	RemoveCase,
+	EmptyCase,
+	RemoveDefault,
+	SliceBoundary,
+	SliceBoundRemoval,
+	IndexShift,
+	InlineGoroutine,
+	RemoveDefer,
+	RemoveLock,
+	RLockToLock,
+	WaitGroupAdd,
+	OnceDo,
+	ChannelBuffer,
+	RemoveClose,
+	SelectDefault,
+	ContextBackground,
+	ContextWrapRemoval,
+	ArgumentSwap,
+	RemoveAppend,
+	RemoveDelete,
+	RemoveMapWrite,
+	FieldRemoval,
+	APISwap,
+	RelationalReplacement,
+	ArithmeticReplacement,
+	LogicalReplacement,
+	BuiltinLength,
+	BuiltinMinMax,
+	BuiltinCopy,
+	RemoveRecover,
+	RemovePanic,
+	LoopSkip,
+	LoopOnce,
+}
+
+// registry holds the names of the Type registered at runtime, such as the
+// rules defined in the configuration.
+var registry struct {
+	sync.RWMutex
+	names []string
+}
+
+// Register returns the dynamic Type with the given name, registering it if
+// it is new. The name is normalised as the ones of the built-in Type, in
+// upper case with underscores, and must not clash with them.
+//
+// The dynamic Type have negative values, so that they never overlap with
+// the built-in ones, and are not part of Types.
+func Register(name string) (Type, error) {
+	name = strings.ToUpper(strings.NewReplacer("-", "_", " ", "_").Replace(strings.TrimSpace(name)))
+	if name == "" {
+		return 0, fmt.Errorf("mutator type name is empty")
+	}
+	for _, mt := range Types {
+		if mt.String() == name {
+			return 0, fmt.Errorf("mutator type %s is already defined", name)
+		}
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	for i, n := range registry.names {
+		if n == name {
+			return Type(-i - 1), nil
+		}
+	}
+	registry.names = append(registry.names, name)
+
+	return Type(-len(registry.names)), nil
+}
+
+// Registered returns the Type registered at runtime, in order of
+// registration.
+func Registered() []Type {
+	registry.RLock()
+	defer registry.RUnlock()
+	types := make([]Type, 0, len(registry.names))
+	for i := range registry.names {
+		types = append(types, Type(-i-1))
+	}
+
+	return types
+}
+
+// IsRegistered tells if the Type has been registered at runtime.
+func (mt Type) IsRegistered() bool {
+	_, ok := registeredName(mt)
+
+	return ok
+}
+
+func registeredName(mt Type) (string, bool) {
+	if mt >= 0 {
+		return "", false
+	}
+	registry.RLock()
+	defer registry.RUnlock()
+	i := int(-mt) - 1
+	if i >= len(registry.names) {
+		return "", false
+	}
+
+	return registry.names[i], true
 }
 
 func (mt Type) String() string {
+	if name, ok := registeredName(mt); ok {
+		return name
+	}
 	switch mt {
 	case ConditionalsBoundary:
 		return "CONDITIONALS_BOUNDARY"
@@ -124,9 +298,101 @@ func (mt Type) String() string {
 		return "INVERT_BWASSIGN"
 	case RemoveSelfAssignments:
 		return "REMOVE_SELF_ASSIGNMENTS"
+	case StatementRemoval:
+		return "STATEMENT_REMOVAL"
+	case ReturnValues:
+		return "RETURN_VALUES"
+	case ErrorReturnNil:
+		return "ERROR_RETURN_NIL"
+	case ErrorGuardRemoval:
+		return "ERROR_GUARD_REMOVAL"
+	case ErrorCheckNegation:
+		return "ERROR_CHECK_NEGATION"
+	case ErrorUnwrap:
+		return "ERROR_UNWRAP"
+	case NumericLiteral:
+		return "NUMERIC_LITERAL"
+	case StringLiteral:
+		return "STRING_LITERAL"
+	case InvertBooleans:
+		return "INVERT_BOOLEANS"
+	case ForceConditions:
+		return "FORCE_CONDITIONS"
+	case RemoveNot:
+		return "REMOVE_NOT"
+	case RemoveCase:
+		return "REMOVE_CASE"
+	case EmptyCase:
+		return "EMPTY_CASE"
+	case RemoveDefault:
+		return "REMOVE_DEFAULT"
+	case SliceBoundary:
+		return "SLICE_BOUNDARY"
+	case SliceBoundRemoval:
+		return "SLICE_BOUND_REMOVAL"
+	case IndexShift:
+		return "INDEX_SHIFT"
+	case InlineGoroutine:
+		return "INLINE_GOROUTINE"
+	case RemoveDefer:
+		return "REMOVE_DEFER"
+	case RemoveLock:
+		return "REMOVE_LOCK"
+	case RLockToLock:
+		return "RLOCK_TO_LOCK"
+	case WaitGroupAdd:
+		return "WAITGROUP_ADD"
+	case OnceDo:
+		return "ONCE_DO"
+	case ChannelBuffer:
+		return "CHANNEL_BUFFER"
+	case RemoveClose:
+		return "REMOVE_CLOSE"
+	case SelectDefault:
+		return "SELECT_DEFAULT"
+	case ContextBackground:
+		return "CONTEXT_BACKGROUND"
+	case ContextWrapRemoval:
+		return "CONTEXT_WRAP_REMOVAL"
+	case ArgumentSwap:
+		return "ARGUMENT_SWAP"
+	case RemoveAppend:
+		return "REMOVE_APPEND"
+	case RemoveDelete:
+		return "REMOVE_DELETE"
+	case RemoveMapWrite:
+		return "REMOVE_MAP_WRITE"
+	case FieldRemoval:
+		return "FIELD_REMOVAL"
+	case APISwap:
+		return "API_SWAP"
+	case RelationalReplacement:
+		return "RELATIONAL_REPLACEMENT"
+	case ArithmeticReplacement:
+		return "ARITHMETIC_REPLACEMENT"
+	case LogicalReplacement:
+		return "LOGICAL_REPLACEMENT"
+	case BuiltinLength:
+		return "BUILTIN_LENGTH"
+	case BuiltinMinMax:
+		return "BUILTIN_MIN_MAX"
+	case BuiltinCopy:
+		return "BUILTIN_COPY"
+	case RemoveRecover:
+		return "REMOVE_RECOVER"
+	case RemovePanic:
+		return "REMOVE_PANIC"
+	case LoopSkip:
+		return "LOOP_SKIP"
+	case LoopOnce:
+		return "LOOP_ONCE"
+	case HigherOrder:
+		return "HIGHER_ORDER"
+	case Extreme:
+		return "EXTREME"
 
 	default:
-		panic("this should not happen")
+		return fmt.Sprintf("UNKNOWN(%d)", int(mt))
 	}
 }
 
@@ -169,3 +435,41 @@ type Mutator interface {
 	// its original status.
 	Rollback() error
 }
+
+// Describer is implemented by the Mutator that can tell which specific
+// mutation they apply, when the Type alone is not enough to identify it.
+type Describer interface {
+	// Description returns a short, human-readable description of the
+	// mutation, or an empty string if there is nothing to add to the Type.
+	Description() string
+}
+
+// RaceRecorder is implemented by the Mutator that can record that its
+// tests failed because of a data race found by the race detector.
+type RaceRecorder interface {
+	// SetRace records if the tests failed because of a data race.
+	SetRace(race bool)
+
+	// Race tells if the tests failed because of a data race.
+	Race() bool
+}
+
+// Race tells if the tests of the Mutator failed because of a data race,
+// if it implements RaceRecorder.
+func Race(m Mutator) bool {
+	if r, ok := m.(RaceRecorder); ok {
+		return r.Race()
+	}
+
+	return false
+}
+
+// Description returns the description of the Mutator if it implements
+// Describer, or an empty string.
+func Description(m Mutator) string {
+	if d, ok := m.(Describer); ok {
+		return d.Description()
+	}
+
+	return ""
+}
@@ -71,6 +71,44 @@ func TestStatusString(t *testing.T) {
 	}
 }
 
+func TestRegister(t *testing.T) {
+	mt, err := mutator.Register("retry-zero")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mt.IsRegistered() {
+		t.Errorf("expected %d to be registered", mt)
+	}
+	if got := mt.String(); got != "RETRY_ZERO" {
+		t.Errorf(cmp.Diff("RETRY_ZERO", got))
+	}
+
+	again, err := mutator.Register("RETRY_ZERO")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != mt {
+		t.Errorf("expected the same type, got %d and %d", mt, again)
+	}
+
+	for _, builtin := range mutator.Types {
+		if builtin.IsRegistered() {
+			t.Errorf("expected %s not to be registered", builtin)
+		}
+	}
+
+	if got := mutator.Registered(); !cmp.Equal(got[len(got)-1], mt) {
+		t.Errorf("expected %d to be the last registered type, got %v", mt, got)
+	}
+
+	if _, err := mutator.Register("api-swap"); err == nil {
+		t.Errorf("expected an error registering a built-in name")
+	}
+	if _, err := mutator.Register(" "); err == nil {
+		t.Errorf("expected an error registering an empty name")
+	}
+}
+
 func TestTypeString(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -132,6 +170,236 @@ func TestTypeString(t *testing.T) {
 			expected:   "REMOVE_SELF_ASSIGNMENTS",
 			mutantType: mutator.RemoveSelfAssignments,
 		},
+		{
+			name:       "STATEMENT_REMOVAL",
+			expected:   "STATEMENT_REMOVAL",
+			mutantType: mutator.StatementRemoval,
+		},
+		{
+			name:       "RETURN_VALUES",
+			expected:   "RETURN_VALUES",
+			mutantType: mutator.ReturnValues,
+		},
+		{
+			name:       "ERROR_RETURN_NIL",
+			expected:   "ERROR_RETURN_NIL",
+			mutantType: mutator.ErrorReturnNil,
+		},
+		{
+			name:       "ERROR_GUARD_REMOVAL",
+			expected:   "ERROR_GUARD_REMOVAL",
+			mutantType: mutator.ErrorGuardRemoval,
+		},
+		{
+			name:       "ERROR_CHECK_NEGATION",
+			expected:   "ERROR_CHECK_NEGATION",
+			mutantType: mutator.ErrorCheckNegation,
+		},
+		{
+			name:       "ERROR_UNWRAP",
+			expected:   "ERROR_UNWRAP",
+			mutantType: mutator.ErrorUnwrap,
+		},
+		{
+			name:       "NUMERIC_LITERAL",
+			expected:   "NUMERIC_LITERAL",
+			mutantType: mutator.NumericLiteral,
+		},
+		{
+			name:       "STRING_LITERAL",
+			expected:   "STRING_LITERAL",
+			mutantType: mutator.StringLiteral,
+		},
+		{
+			name:       "INVERT_BOOLEANS",
+			expected:   "INVERT_BOOLEANS",
+			mutantType: mutator.InvertBooleans,
+		},
+		{
+			name:       "FORCE_CONDITIONS",
+			expected:   "FORCE_CONDITIONS",
+			mutantType: mutator.ForceConditions,
+		},
+		{
+			name:       "REMOVE_NOT",
+			expected:   "REMOVE_NOT",
+			mutantType: mutator.RemoveNot,
+		},
+		{
+			name:       "REMOVE_CASE",
+			expected:   "REMOVE_CASE",
+			mutantType: mutator.RemoveCase,
+		},
+		{
+			name:       "EMPTY_CASE",
+			expected:   "EMPTY_CASE",
+			mutantType: mutator.EmptyCase,
+		},
+		{
+			name:       "REMOVE_DEFAULT",
+			expected:   "REMOVE_DEFAULT",
+			mutantType: mutator.RemoveDefault,
+		},
+		{
+			name:       "SLICE_BOUNDARY",
+			expected:   "SLICE_BOUNDARY",
+			mutantType: mutator.SliceBoundary,
+		},
+		{
+			name:       "SLICE_BOUND_REMOVAL",
+			expected:   "SLICE_BOUND_REMOVAL",
+			mutantType: mutator.SliceBoundRemoval,
+		},
+		{
+			name:       "INDEX_SHIFT",
+			expected:   "INDEX_SHIFT",
+			mutantType: mutator.IndexShift,
+		},
+		{
+			name:       "INLINE_GOROUTINE",
+			expected:   "INLINE_GOROUTINE",
+			mutantType: mutator.InlineGoroutine,
+		},
+		{
+			name:       "REMOVE_DEFER",
+			expected:   "REMOVE_DEFER",
+			mutantType: mutator.RemoveDefer,
+		},
+		{
+			name:       "REMOVE_LOCK",
+			expected:   "REMOVE_LOCK",
+			mutantType: mutator.RemoveLock,
+		},
+		{
+			name:       "RLOCK_TO_LOCK",
+			expected:   "RLOCK_TO_LOCK",
+			mutantType: mutator.RLockToLock,
+		},
+		{
+			name:       "WAITGROUP_ADD",
+			expected:   "WAITGROUP_ADD",
+			mutantType: mutator.WaitGroupAdd,
+		},
+		{
+			name:       "ONCE_DO",
+			expected:   "ONCE_DO",
+			mutantType: mutator.OnceDo,
+		},
+		{
+			name:       "CHANNEL_BUFFER",
+			expected:   "CHANNEL_BUFFER",
+			mutantType: mutator.ChannelBuffer,
+		},
+		{
+			name:       "REMOVE_CLOSE",
+			expected:   "REMOVE_CLOSE",
+			mutantType: mutator.RemoveClose,
+		},
+		{
+			name:       "SELECT_DEFAULT",
+			expected:   "SELECT_DEFAULT",
+			mutantType: mutator.SelectDefault,
+		},
+		{
+			name:       "CONTEXT_BACKGROUND",
+			expected:   "CONTEXT_BACKGROUND",
+			mutantType: mutator.ContextBackground,
+		},
+		{
+			name:       "CONTEXT_WRAP_REMOVAL",
+			expected:   "CONTEXT_WRAP_REMOVAL",
+			mutantType: mutator.ContextWrapRemoval,
+		},
+		{
+			name:       "ARGUMENT_SWAP",
+			expected:   "ARGUMENT_SWAP",
+			mutantType: mutator.ArgumentSwap,
+		},
+		{
+			name:       "REMOVE_APPEND",
+			expected:   "REMOVE_APPEND",
+			mutantType: mutator.RemoveAppend,
+		},
+		{
+			name:       "REMOVE_DELETE",
+			expected:   "REMOVE_DELETE",
+			mutantType: mutator.RemoveDelete,
+		},
+		{
+			name:       "REMOVE_MAP_WRITE",
+			expected:   "REMOVE_MAP_WRITE",
+			mutantType: mutator.RemoveMapWrite,
+		},
+		{
+			name:       "FIELD_REMOVAL",
+			expected:   "FIELD_REMOVAL",
+			mutantType: mutator.FieldRemoval,
+		},
+		{
+			name:       "API_SWAP",
+			expected:   "API_SWAP",
+			mutantType: mutator.APISwap,
+		},
+		{
+			name:       "HIGHER_ORDER",
+			expected:   "HIGHER_ORDER",
+			mutantType: mutator.HigherOrder,
+		},
+		{
+			name:       "EXTREME",
+			expected:   "EXTREME",
+			mutantType: mutator.Extreme,
+		},
+		{
+			name:       "RELATIONAL_REPLACEMENT",
+			expected:   "RELATIONAL_REPLACEMENT",
+			mutantType: mutator.RelationalReplacement,
+		},
+		{
+			name:       "ARITHMETIC_REPLACEMENT",
+			expected:   "ARITHMETIC_REPLACEMENT",
+			mutantType: mutator.ArithmeticReplacement,
+		},
+		{
+			name:       "LOGICAL_REPLACEMENT",
+			expected:   "LOGICAL_REPLACEMENT",
+			mutantType: mutator.LogicalReplacement,
+		},
+		{
+			name:       "BUILTIN_LENGTH",
+			expected:   "BUILTIN_LENGTH",
+			mutantType: mutator.BuiltinLength,
+		},
+		{
+			name:       "BUILTIN_MIN_MAX",
+			expected:   "BUILTIN_MIN_MAX",
+			mutantType: mutator.BuiltinMinMax,
+		},
+		{
+			name:       "BUILTIN_COPY",
+			expected:   "BUILTIN_COPY",
+			mutantType: mutator.BuiltinCopy,
+		},
+		{
+			name:       "REMOVE_RECOVER",
+			expected:   "REMOVE_RECOVER",
+			mutantType: mutator.RemoveRecover,
+		},
+		{
+			name:       "REMOVE_PANIC",
+			expected:   "REMOVE_PANIC",
+			mutantType: mutator.RemovePanic,
+		},
+		{
+			name:       "LOOP_SKIP",
+			expected:   "LOOP_SKIP",
+			mutantType: mutator.LoopSkip,
+		},
+		{
+			name:       "LOOP_ONCE",
+			expected:   "LOOP_ONCE",
+			mutantType: mutator.LoopOnce,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -16,6 +16,12 @@
 
 package internal
 
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
 // OutputResult is the data structure for the Gremlins file output format.
 type OutputResult struct {
 	GoModule          string       `json:"go_module"`
@@ -24,11 +30,14 @@ type OutputResult struct {
 	MutationsCoverage float64      `json:"mutations_coverage"`
 	MutantsTotal      int          `json:"mutants_total"`
 	MutantsKilled     int          `json:"mutants_killed"`
+	MutantsRaces      int          `json:"mutants_killed_by_race,omitempty"`
 	MutantsLived      int          `json:"mutants_lived"`
 	MutantsNotViable  int          `json:"mutants_not_viable"`
 	MutantsNotCovered int          `json:"mutants_not_covered"`
 	ElapsedTime       float64      `json:"elapsed_time"`
 	MutatorStatistics MutatorType  `json:"mutator_statistics"`
+
+	PseudoTestedFunctions []PseudoTestedFunction `json:"pseudo_tested_functions,omitempty"`
 }
 
 // OutputFile represents a single file in the OutputResult data structure.
@@ -39,10 +48,20 @@ type OutputFile struct {
 
 // Mutation represents a single mutation in the OutputResult data structure.
 type Mutation struct {
-	Type   string `json:"type"`
-	Status string `json:"status"`
-	Line   int    `json:"line"`
-	Column int    `json:"column"`
+	Type        string `json:"type"`
+	Status      string `json:"status"`
+	Description string `json:"description,omitempty"`
+	Line        int    `json:"line"`
+	Column      int    `json:"column"`
+	Race        bool   `json:"race,omitempty"`
+}
+
+// PseudoTestedFunction represents a function whose body has been removed
+// in the extreme mode, without any test failing.
+type PseudoTestedFunction struct {
+	Function string `json:"function"`
+	Filename string `json:"file_name"`
+	Line     int    `json:"line"`
 }
 
 // MutatorType contains the list of all supported mutator types.
@@ -58,4 +77,102 @@ type MutatorType struct {
 	InvertLoopCtrl           int `json:"invert_loop_ctrl,omitempty"`
 	InvertNegatives          int `json:"invert_negatives,omitempty"`
 	RemoveSelfAssignments    int `json:"remove_self_assignments,omitempty"`
+	StatementRemoval         int `json:"statement_removal,omitempty"`
+	ReturnValues             int `json:"return_values,omitempty"`
+	ErrorReturnNil           int `json:"error_return_nil,omitempty"`
+	ErrorGuardRemoval        int `json:"error_guard_removal,omitempty"`
+	ErrorCheckNegation       int `json:"error_check_negation,omitempty"`
+	ErrorUnwrap              int `json:"error_unwrap,omitempty"`
+	NumericLiteral           int `json:"numeric_literal,omitempty"`
+	StringLiteral            int `json:"string_literal,omitempty"`
+	InvertBooleans           int `json:"invert_booleans,omitempty"`
+	ForceConditions          int `json:"force_conditions,omitempty"`
+	RemoveNot                int `json:"remove_not,omitempty"`
+	RemoveCase               int `json:"remove_case,omitempty"`
+	EmptyCase                int `json:"empty_case,omitempty"`
+	RemoveDefault            int `json:"remove_default,omitempty"`
+	SliceBoundary            int `json:"slice_boundary,omitempty"`
+	SliceBoundRemoval        int `json:"slice_bound_removal,omitempty"`
+	IndexShift               int `json:"index_shift,omitempty"`
+	InlineGoroutine          int `json:"inline_goroutine,omitempty"`
+	RemoveDefer              int `json:"remove_defer,omitempty"`
+	RemoveLock               int `json:"remove_lock,omitempty"`
+	RLockToLock              int `json:"rlock_to_lock,omitempty"`
+	WaitGroupAdd             int `json:"waitgroup_add,omitempty"`
+	OnceDo                   int `json:"once_do,omitempty"`
+	ChannelBuffer            int `json:"channel_buffer,omitempty"`
+	RemoveClose              int `json:"remove_close,omitempty"`
+	SelectDefault            int `json:"select_default,omitempty"`
+	ContextBackground        int `json:"context_background,omitempty"`
+	ContextWrapRemoval       int `json:"context_wrap_removal,omitempty"`
+	ArgumentSwap             int `json:"argument_swap,omitempty"`
+	RemoveAppend             int `json:"remove_append,omitempty"`
+	RemoveDelete             int `json:"remove_delete,omitempty"`
+	RemoveMapWrite           int `json:"remove_map_write,omitempty"`
+	FieldRemoval             int `json:"field_removal,omitempty"`
+	APISwap                  int `json:"api_swap,omitempty"`
+	RelationalReplacement    int `json:"relational_replacement,omitempty"`
+	ArithmeticReplacement    int `json:"arithmetic_replacement,omitempty"`
+	LogicalReplacement       int `json:"logical_replacement,omitempty"`
+	BuiltinLength            int `json:"builtin_length,omitempty"`
+	BuiltinMinMax            int `json:"builtin_min_max,omitempty"`
+	BuiltinCopy              int `json:"builtin_copy,omitempty"`
+	RemoveRecover            int `json:"remove_recover,omitempty"`
+	RemovePanic              int `json:"remove_panic,omitempty"`
+	LoopSkip                 int `json:"loop_skip,omitempty"`
+	LoopOnce                 int `json:"loop_once,omitempty"`
+	HigherOrder              int `json:"higher_order,omitempty"`
+	Extreme                  int `json:"extreme,omitempty"`
+
+	// Rules holds the counts of the mutator types registered at runtime,
+	// such as the rules of the configuration, keyed by their name.
+	Rules map[string]int `json:"-"`
+}
+
+// mutatorType has the fields of MutatorType, without its JSON methods.
+type mutatorType MutatorType
+
+// MarshalJSON implements the json.Marshaler interface, reporting the Rules
+// next to the built-in mutator types.
+func (m MutatorType) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(mutatorType(m))
+	if err != nil || len(m.Rules) == 0 {
+		return b, err
+	}
+	all := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &all); err != nil {
+		return nil, err
+	}
+	for name, count := range m.Rules {
+		if _, ok := all[name]; ok {
+			continue
+		}
+		all[name], _ = json.Marshal(count)
+	}
+
+	return json.Marshal(all)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, gathering the
+// unknown keys in the Rules.
+func (m *MutatorType) UnmarshalJSON(b []byte) error {
+	var known mutatorType
+	if err := json.Unmarshal(b, &known); err != nil {
+		return err
+	}
+	var all map[string]int
+	if err := json.Unmarshal(b, &all); err != nil {
+		return err
+	}
+	t := reflect.TypeOf(known)
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		delete(all, name)
+	}
+	if len(all) > 0 {
+		known.Rules = all
+	}
+	*m = MutatorType(known)
+
+	return nil
 }
@@ -19,6 +19,8 @@ package report
 import (
 	"encoding/json"
 	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/fatih/color"
@@ -55,6 +57,7 @@ type reportStatus struct {
 	module  string
 
 	killed     int
+	races      int
 	lived      int
 	timedOut   int
 	notCovered int
@@ -63,6 +66,7 @@ type reportStatus struct {
 	runnable   int
 
 	mutatorStatistics internal.MutatorType
+	pseudoTested      []internal.PseudoTestedFunction
 
 	tEfficacy float64
 	mCovered  float64
@@ -80,15 +84,26 @@ func newReport(results Results) (*reportStatus, bool) {
 	rep.files = make(map[string][]internal.Mutation)
 	for _, m := range results.Mutants {
 		rep.files[m.Position().Filename] = append(rep.files[m.Position().Filename], internal.Mutation{
-			Line:   m.Position().Line,
-			Column: m.Position().Column,
-			Type:   m.Type().String(),
-			Status: m.Status().String(),
+			Line:        m.Position().Line,
+			Column:      m.Position().Column,
+			Type:        m.Type().String(),
+			Status:      m.Status().String(),
+			Description: mutator.Description(m),
+			Race:        mutator.Race(m),
 		})
 
 		reportMutationStatus(m, rep)
 		reportMutatorType(m, rep)
+		reportPseudoTested(m, rep)
 	}
+	sort.Slice(rep.pseudoTested, func(i, j int) bool {
+		a, b := rep.pseudoTested[i], rep.pseudoTested[j]
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+
+		return a.Line < b.Line
+	})
 	if !rep.isDryRun() {
 		if rep.killed > 0 {
 			rep.tEfficacy = float64(rep.killed) / float64(rep.killed+rep.lived) * 100
@@ -107,6 +122,9 @@ func reportMutationStatus(m mutator.Mutator, rep *reportStatus) {
 	switch m.Status() {
 	case mutator.Killed:
 		rep.killed++
+		if mutator.Race(m) {
+			rep.races++
+		}
 	case mutator.Lived:
 		rep.lived++
 	case mutator.NotCovered:
@@ -146,9 +164,122 @@ func reportMutatorType(m mutator.Mutator, rep *reportStatus) {
 		rep.mutatorStatistics.InvertNegatives++
 	case mutator.RemoveSelfAssignments:
 		rep.mutatorStatistics.RemoveSelfAssignments++
+	case mutator.StatementRemoval:
+		rep.mutatorStatistics.StatementRemoval++
+	case mutator.ReturnValues:
+		rep.mutatorStatistics.ReturnValues++
+	case mutator.ErrorReturnNil:
+		rep.mutatorStatistics.ErrorReturnNil++
+	case mutator.ErrorGuardRemoval:
+		rep.mutatorStatistics.ErrorGuardRemoval++
+	case mutator.ErrorCheckNegation:
+		rep.mutatorStatistics.ErrorCheckNegation++
+	case mutator.ErrorUnwrap:
+		rep.mutatorStatistics.ErrorUnwrap++
+	case mutator.NumericLiteral:
+		rep.mutatorStatistics.NumericLiteral++
+	case mutator.StringLiteral:
+		rep.mutatorStatistics.StringLiteral++
+	case mutator.InvertBooleans:
+		rep.mutatorStatistics.InvertBooleans++
+	case mutator.ForceConditions:
+		rep.mutatorStatistics.ForceConditions++
+	case mutator.RemoveNot:
+		rep.mutatorStatistics.RemoveNot++
+	case mutator.RemoveCase:
+		rep.mutatorStatistics.RemoveCase++
+	case mutator.EmptyCase:
+		rep.mutatorStatistics.EmptyCase++
+	case mutator.RemoveDefault:
+		rep.mutatorStatistics.RemoveDefault++
+	case mutator.SliceBoundary:
+		rep.mutatorStatistics.SliceBoundary++
+	case mutator.SliceBoundRemoval:
+		rep.mutatorStatistics.SliceBoundRemoval++
+	case mutator.IndexShift:
+		rep.mutatorStatistics.IndexShift++
+	case mutator.InlineGoroutine:
+		rep.mutatorStatistics.InlineGoroutine++
+	case mutator.RemoveDefer:
+		rep.mutatorStatistics.RemoveDefer++
+	case mutator.RemoveLock:
+		rep.mutatorStatistics.RemoveLock++
+	case mutator.RLockToLock:
+		rep.mutatorStatistics.RLockToLock++
+	case mutator.WaitGroupAdd:
+		rep.mutatorStatistics.WaitGroupAdd++
+	case mutator.OnceDo:
+		rep.mutatorStatistics.OnceDo++
+	case mutator.ChannelBuffer:
+		rep.mutatorStatistics.ChannelBuffer++
+	case mutator.RemoveClose:
+		rep.mutatorStatistics.RemoveClose++
+	case mutator.SelectDefault:
+		rep.mutatorStatistics.SelectDefault++
+	case mutator.ContextBackground:
+		rep.mutatorStatistics.ContextBackground++
+	case mutator.ContextWrapRemoval:
+		rep.mutatorStatistics.ContextWrapRemoval++
+	case mutator.ArgumentSwap:
+		rep.mutatorStatistics.ArgumentSwap++
+	case mutator.RemoveAppend:
+		rep.mutatorStatistics.RemoveAppend++
+	case mutator.RemoveDelete:
+		rep.mutatorStatistics.RemoveDelete++
+	case mutator.RemoveMapWrite:
+		rep.mutatorStatistics.RemoveMapWrite++
+	case mutator.FieldRemoval:
+		rep.mutatorStatistics.FieldRemoval++
+	case mutator.APISwap:
+		rep.mutatorStatistics.APISwap++
+	case mutator.RelationalReplacement:
+		rep.mutatorStatistics.RelationalReplacement++
+	case mutator.ArithmeticReplacement:
+		rep.mutatorStatistics.ArithmeticReplacement++
+	case mutator.LogicalReplacement:
+		rep.mutatorStatistics.LogicalReplacement++
+	case mutator.BuiltinLength:
+		rep.mutatorStatistics.BuiltinLength++
+	case mutator.BuiltinMinMax:
+		rep.mutatorStatistics.BuiltinMinMax++
+	case mutator.BuiltinCopy:
+		rep.mutatorStatistics.BuiltinCopy++
+	case mutator.RemoveRecover:
+		rep.mutatorStatistics.RemoveRecover++
+	case mutator.RemovePanic:
+		rep.mutatorStatistics.RemovePanic++
+	case mutator.LoopSkip:
+		rep.mutatorStatistics.LoopSkip++
+	case mutator.LoopOnce:
+		rep.mutatorStatistics.LoopOnce++
+	case mutator.HigherOrder:
+		rep.mutatorStatistics.HigherOrder++
+	case mutator.Extreme:
+		rep.mutatorStatistics.Extreme++
+	default:
+		if !m.Type().IsRegistered() {
+			return
+		}
+		if rep.mutatorStatistics.Rules == nil {
+			rep.mutatorStatistics.Rules = make(map[string]int)
+		}
+		rep.mutatorStatistics.Rules[strings.ToLower(m.Type().String())]++
 	}
 }
 
+// reportPseudoTested gathers the functions whose body has been removed by
+// the extreme mode without any test noticing.
+func reportPseudoTested(m mutator.Mutator, rep *reportStatus) {
+	if m.Type() != mutator.Extreme || m.Status() != mutator.Lived {
+		return
+	}
+	rep.pseudoTested = append(rep.pseudoTested, internal.PseudoTestedFunction{
+		Function: mutator.Description(m),
+		Filename: m.Position().Filename,
+		Line:     m.Position().Line,
+	})
+}
+
 func (*reportStatus) isDryRun() bool {
 	return configuration.Get[bool](configuration.UnleashDryRunKey)
 }
@@ -158,6 +289,9 @@ func (r *reportStatus) reportFindings() {
 		r.dryRunReport()
 	} else {
 		r.fullRunReport()
+		if r.mutatorStatistics.Extreme > 0 {
+			r.pseudoTestedReport()
+		}
 	}
 	r.fileReport()
 }
@@ -177,12 +311,15 @@ func (r *reportStatus) fileReport() {
 			MutationsCoverage: r.mCovered,
 			MutantsTotal:      r.lived + r.killed + r.notViable,
 			MutantsKilled:     r.killed,
+			MutantsRaces:      r.races,
 			MutantsLived:      r.lived,
 			MutantsNotViable:  r.notViable,
 			MutantsNotCovered: r.notCovered,
 			ElapsedTime:       r.elapsed.Duration().Seconds(),
 			MutatorStatistics: r.mutatorStatistics,
 			Files:             files,
+
+			PseudoTestedFunctions: r.pseudoTested,
 		}
 
 		jsonResult, _ := json.Marshal(result)
@@ -220,10 +357,21 @@ func (r *reportStatus) fullRunReport() {
 	log.Infof("Mutation testing completed in %s\n", r.elapsed.String())
 	log.Infof("Killed: %s, Lived: %s, Not covered: %s\n", killed, lived, notCovered)
 	log.Infof("Timed out: %s, Not viable: %s, Skipped: %s\n", timedOut, notViable, skipped)
+	if r.races > 0 {
+		log.Infof("Killed by data races: %s\n", fgRed(r.races))
+	}
 	log.Infof("Test efficacy: %.2f%%\n", r.tEfficacy)
 	log.Infof("Mutator coverage: %.2f%%\n", r.mCovered)
 }
 
+func (r *reportStatus) pseudoTestedReport() {
+	log.Infoln("")
+	log.Infof("Pseudo-tested functions: %s\n", fgRed(len(r.pseudoTested)))
+	for _, f := range r.pseudoTested {
+		log.Infof("  %s at %s:%d\n", f.Function, f.Filename, f.Line)
+	}
+}
+
 func (r *reportStatus) assess(tEfficacy, rCoverage float64) error {
 	if r.isDryRun() {
 		return nil
@@ -264,7 +412,8 @@ func Do(results Results) error {
 }
 
 // Mutant logs a mutator.Mutator.
-// It reports the mutant.Status, the mutator.Type and its position.
+// It reports the mutant.Status, the mutator.Type, its description if the
+// mutator.Mutator implements mutator.Describer, and its position.
 // This function uses the log package in gremlins to write to the
 // chosen io.Writer, so it is necessary to call log.Init before
 // the report generation.
@@ -282,12 +431,24 @@ func Mutant(m mutator.Mutator) {
 	case mutator.NotViable, mutator.Skipped:
 		status = fgHiBlack(m.Status())
 	}
-	log.Infof("%s%s %s at %s\n", padding(m.Status()), status, m.Type(), m.Position())
+	mt := m.Type().String()
+	if desc := mutator.Description(m); desc != "" {
+		mt += " (" + desc + ")"
+	}
+	log.Infof("%s%s %s at %s\n", padding(m.Status().String()), status, mt, m.Position())
+}
+
+// Race logs that the tests of a mutator.Mutator failed because of a data
+// race detected by the race detector.
+// As for Mutant, it is necessary to call log.Init before using it.
+func Race(m mutator.Mutator) {
+	const race = "DATA RACE"
+	log.Infof("%s%s %s at %s\n", padding(race), fgRed(race), m.Type(), m.Position())
 }
 
-func padding(s mutator.Status) string {
+func padding(s string) string {
 	var pad string
-	padLen := 12 - len(s.String())
+	padLen := 12 - len(s)
 	for i := 0; i < padLen; i++ {
 		pad += " "
 	}
@@ -72,6 +72,21 @@ func TestReport(t *testing.T) {
 				"Test efficacy: 50.00%\n" +
 				"Mutator coverage: 66.67%\n",
 		},
+		{
+			name: "reports findings killed by data races",
+			mutants: []mutator.Mutator{
+				stubMutant{status: mutator.Killed, mutantType: mutator.RemoveLock, position: fakePosition, race: true},
+				stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: fakePosition},
+			},
+			want: "\n" +
+				// Limit the time reporting to the first two units (millis are excluded)
+				testingLine +
+				"Killed: 2, Lived: 0, Not covered: 0\n" +
+				"Timed out: 0, Not viable: 0, Skipped: 0\n" +
+				"Killed by data races: 1\n" +
+				"Test efficacy: 100.00%\n" +
+				"Mutator coverage: 100.00%\n",
+		},
 		{
 			name: "reports findings with no coverage",
 			mutants: []mutator.Mutator{
@@ -99,6 +114,25 @@ func TestReport(t *testing.T) {
 				"Test efficacy: 0.00%\n" +
 				coverageLine,
 		},
+		{
+			name: "reports pseudo-tested functions in extreme mode",
+			mutants: []mutator.Mutator{
+				stubMutant{status: mutator.Lived, mutantType: mutator.Extreme, position: newPosition("b.go", 2, 7), description: "g"},
+				stubMutant{status: mutator.Killed, mutantType: mutator.Extreme, position: fakePosition, description: "f"},
+				stubMutant{status: mutator.Lived, mutantType: mutator.Extreme, position: newPosition("a.go", 2, 3), description: "(*T).Close"},
+			},
+			want: "\n" +
+				// Limit the time reporting to the first two units (millis are excluded)
+				testingLine +
+				"Killed: 1, Lived: 2, Not covered: 0\n" +
+				"Timed out: 0, Not viable: 0, Skipped: 0\n" +
+				"Test efficacy: 33.33%\n" +
+				"Mutator coverage: 100.00%\n" +
+				"\n" +
+				"Pseudo-tested functions: 2\n" +
+				"  (*T).Close at a.go:3\n" +
+				"  g at b.go:7\n",
+		},
 		{
 			name:    "reports nothing if no result",
 			mutants: []mutator.Mutator{},
@@ -311,6 +345,8 @@ func TestMutantLog(t *testing.T) {
 	report.Mutant(m)
 	m = stubMutant{status: mutator.TimedOut, mutantType: mutator.ConditionalsBoundary, position: fakePosition}
 	report.Mutant(m)
+	m = stubMutant{status: mutator.Lived, mutantType: mutator.RemoveCase, position: fakePosition, description: "case 1"}
+	report.Mutant(m)
 
 	got := out.String()
 
@@ -320,7 +356,26 @@ func TestMutantLog(t *testing.T) {
 		" NOT COVERED CONDITIONALS_BOUNDARY at aFolder/aFile.go:12:3\n" +
 		"    RUNNABLE CONDITIONALS_BOUNDARY at aFolder/aFile.go:12:3\n" +
 		"  NOT VIABLE CONDITIONALS_BOUNDARY at aFolder/aFile.go:12:3\n" +
-		"   TIMED OUT CONDITIONALS_BOUNDARY at aFolder/aFile.go:12:3\n"
+		"   TIMED OUT CONDITIONALS_BOUNDARY at aFolder/aFile.go:12:3\n" +
+		"       LIVED REMOVE_CASE (case 1) at aFolder/aFile.go:12:3\n"
+
+	if !cmp.Equal(got, want) {
+		t.Errorf(cmp.Diff(got, want))
+	}
+}
+
+func TestRaceLog(t *testing.T) {
+	out := &bytes.Buffer{}
+	defer out.Reset()
+	log.Init(out, &bytes.Buffer{})
+	defer log.Reset()
+
+	m := stubMutant{status: mutator.Killed, mutantType: mutator.RemoveLock, position: fakePosition}
+	report.Race(m)
+
+	got := out.String()
+
+	want := "   DATA RACE REMOVE_LOCK at aFolder/aFile.go:12:3\n"
 
 	if !cmp.Equal(got, want) {
 		t.Errorf(cmp.Diff(got, want))
@@ -329,6 +384,10 @@ func TestMutantLog(t *testing.T) {
 
 func TestReportToFile(t *testing.T) {
 	outFile := "findings.json"
+	retryZero, err := mutator.Register("retry-zero")
+	if err != nil {
+		t.Fatal(err)
+	}
 	mutants := []mutator.Mutator{
 		stubMutant{status: mutator.Killed, mutantType: mutator.ConditionalsNegation, position: newPosition("file1.go", 3, 10)},
 		stubMutant{status: mutator.Lived, mutantType: mutator.ArithmeticBase, position: newPosition("file1.go", 8, 20)},
@@ -342,6 +401,9 @@ func TestReportToFile(t *testing.T) {
 		stubMutant{status: mutator.Lived, mutantType: mutator.InvertLogical, position: newPosition("file2.go", 4, 11)},
 		stubMutant{status: mutator.NotViable, mutantType: mutator.InvertNegatives, position: newPosition("file3.go", 4, 200)},
 		stubMutant{status: mutator.Killed, mutantType: mutator.RemoveSelfAssignments, position: newPosition("file3.go", 4, 100)},
+		stubMutant{status: mutator.Skipped, mutantType: mutator.ReturnValues, position: newPosition("file3.go", 2, 120)},
+		stubMutant{status: mutator.Skipped, mutantType: mutator.RemoveCase, position: newPosition("file3.go", 2, 130), description: "case 1"},
+		stubMutant{status: mutator.Skipped, mutantType: retryZero, position: newPosition("file3.go", 2, 140)},
 	}
 	data := report.Results{
 		Module:  "example.com/go/module",
@@ -411,6 +473,81 @@ func TestReportToFile(t *testing.T) {
 	})
 }
 
+func TestReportRacesToFile(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "findings.json")
+	viper.Set(configuration.UnleashOutputKey, output)
+	defer viper.Reset()
+
+	data := report.Results{
+		Module: "example.com/go/module",
+		Mutants: []mutator.Mutator{
+			stubMutant{status: mutator.Killed, mutantType: mutator.RemoveLock, position: newPosition("file1.go", 2, 10), race: true},
+			stubMutant{status: mutator.Killed, mutantType: mutator.RemoveLock, position: newPosition("file1.go", 2, 20)},
+		},
+		Elapsed: 2 * time.Minute,
+	}
+	if err := report.Do(data); err != nil {
+		t.Fatal("error not expected")
+	}
+
+	file, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal("file not found")
+	}
+	var got internal.OutputResult
+	if err := json.Unmarshal(file, &got); err != nil {
+		t.Fatal("impossible to unmarshal results")
+	}
+
+	if got.MutantsRaces != 1 {
+		t.Errorf("expected 1 mutant killed by a data race, got %d", got.MutantsRaces)
+	}
+	var races []int
+	for _, m := range got.Files[0].Mutations {
+		if m.Race {
+			races = append(races, m.Line)
+		}
+	}
+	if want := []int{10}; !cmp.Equal(races, want) {
+		t.Errorf(cmp.Diff(want, races))
+	}
+}
+
+func TestReportPseudoTestedToFile(t *testing.T) {
+	output := filepath.Join(t.TempDir(), "findings.json")
+	viper.Set(configuration.UnleashOutputKey, output)
+	defer viper.Reset()
+
+	data := report.Results{
+		Module: "example.com/go/module",
+		Mutants: []mutator.Mutator{
+			stubMutant{status: mutator.Lived, mutantType: mutator.Extreme, position: newPosition("file1.go", 2, 10), description: "f"},
+			stubMutant{status: mutator.Killed, mutantType: mutator.Extreme, position: newPosition("file1.go", 2, 20), description: "g"},
+		},
+		Elapsed: 2 * time.Minute,
+	}
+	if err := report.Do(data); err != nil {
+		t.Fatal("error not expected")
+	}
+
+	file, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal("file not found")
+	}
+	var got internal.OutputResult
+	if err := json.Unmarshal(file, &got); err != nil {
+		t.Fatal("impossible to unmarshal results")
+	}
+
+	want := []internal.PseudoTestedFunction{{Function: "f", Filename: "file1.go", Line: 10}}
+	if !cmp.Equal(got.PseudoTestedFunctions, want) {
+		t.Errorf(cmp.Diff(want, got.PseudoTestedFunctions))
+	}
+	if got.MutatorStatistics.Extreme != 2 {
+		t.Errorf("expected 2 extreme mutants, got %d", got.MutatorStatistics.Extreme)
+	}
+}
+
 func notWriteableDir(t *testing.T) (string, func()) {
 	t.Helper()
 	tmp := t.TempDir()
@@ -441,9 +578,11 @@ func sortMutation(x, y internal.Mutation) bool {
 }
 
 type stubMutant struct {
-	position   token.Position
-	status     mutator.Status
-	mutantType mutator.Type
+	position    token.Position
+	status      mutator.Status
+	mutantType  mutator.Type
+	description string
+	race        bool
 }
 
 func (s stubMutant) Type() mutator.Type {
@@ -489,3 +628,15 @@ func (stubMutant) Apply() error {
 func (stubMutant) Rollback() error {
 	panic("implement me")
 }
+
+func (s stubMutant) Description() string {
+	return s.description
+}
+
+func (stubMutant) SetRace(_ bool) {
+	panic("implement me")
+}
+
+func (s stubMutant) Race() bool {
+	return s.race
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright 2022 The Gremlins Authors
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+// Command custom is an example of a build of Gremlins including a custom
+// mutation operator, which can be kept in a repository of its own.
+//
+// The operator is registered before executing the command, so that it
+// gets its own flag and configuration key, as the built-in ones:
+//
+//	go run ./examples/custom unleash --zero-sleep
+package main
+
+import (
+	"context"
+	"errors"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/fatih/color"
+
+	"github.com/go-maxhub/gremlins/cmd"
+	"github.com/go-maxhub/gremlins/core/engine"
+	"github.com/go-maxhub/gremlins/core/execution"
+	"github.com/go-maxhub/gremlins/core/log"
+)
+
+func main() {
+	var exitErr *execution.ExitError
+	var exitCode int
+	defer func() {
+		os.Exit(exitCode)
+	}()
+	log.Init(color.Output, color.Error)
+
+	_, err := engine.Register(engine.Operator{Name: "ZERO_SLEEP", Find: findZeroSleeps})
+	if err != nil {
+		log.Errorln(err)
+		exitCode = 1
+
+		return
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	err = cmd.Execute(ctx, "custom")
+	if err != nil {
+		log.Errorln(err)
+		exitCode = 1
+	}
+	if errors.As(err, &exitErr) {
+		exitCode = exitErr.ExitCode()
+	}
+}
+
+// findZeroSleeps replaces the duration passed to time.Sleep with 0, to
+// check that the tests don't rely on the timing of the code.
+func findZeroSleeps(n ast.Node, c engine.Cursor) []engine.NodeMutation {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return nil
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || c.Info() == nil {
+		return nil
+	}
+	f, ok := c.Info().Uses[sel.Sel].(*types.Func)
+	if !ok || f.Pkg() == nil || f.Pkg().Path() != "time" || f.Name() != "Sleep" {
+		return nil
+	}
+	arg := &call.Args[0]
+
+	return []engine.NodeMutation{{
+		Pos: (*arg).Pos(),
+		Mutate: func() func() {
+			orig := *arg
+			*arg = &ast.BasicLit{ValuePos: orig.Pos(), Kind: token.INT, Value: "0"}
+
+			return func() {
+				*arg = orig
+			}
+		},
+	}}
+}